@@ -0,0 +1,18 @@
+package jsonValidator
+
+// Address is a postal address form, to be declared as a "type=struct" field of the forms (or a "type=[]struct" list):
+//
+//	type Object struct {
+//		ShippingAddress *jsonValidator.Address `validations:"type=struct;required=true"`
+//	}
+//
+// The country is an ISO 3166-1 alpha-2 code ("ES", "US"...). The region (state, province...) is required in the
+// countries that use it in their addresses, and the postal code everywhere but in the countries without postal codes.
+type Address struct {
+	Line1      *string `validations:"type=string;required=true;min=1;max=100"`
+	Line2      *string `validations:"type=string;max=100"`
+	City       *string `validations:"type=string;required=true;min=1;max=100"`
+	Region     *string `validations:"type=string;max=100;required_if=country:AU,BR,CA,CN,IN,MX,US"`
+	PostalCode *string `validations:"type=string;max=20;pattern=^[A-Za-z0-9][A-Za-z0-9 -]*$;required_unless=country:AE,AG,AO,BS,BZ,FJ,GH,HK,JM,QA,ZW"`
+	Country    *string `validations:"type=string;required=true;pattern=^[A-Z]{2}$"`
+}
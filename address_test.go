@@ -0,0 +1,55 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_Address(t *testing.T) {
+	type createObject struct {
+		Address *Address `validations:"type=struct;required=true"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_address",
+			jsonData: []byte(`{"address": {"line1": "Gran Via 1", "city": "Madrid", "postalCode": "28013", "country": "ES"}}`),
+			want:     nil,
+		},
+		{
+			name:     "test_address_without_postal_code",
+			jsonData: []byte(`{"address": {"line1": "1 Queen's Road Central", "city": "Hong Kong", "country": "HK"}}`),
+			want:     nil,
+		},
+		{
+			name:     "test_address_country_requirements",
+			jsonData: []byte(`{"address": {"line1": "1600 Amphitheatre Pkwy", "city": "Mountain View", "country": "US"}}`),
+			want: []error{
+				ValidationError{Field: "address.postalCode", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "address.region", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+		{
+			name:     "test_address_invalid",
+			jsonData: []byte(`{"address": {"line1": "Gran Via 1", "postalCode": "#28013", "country": "spain"}}`),
+			want: []error{
+				ValidationError{Field: "address.city", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "address.country", Message: defaultMessage("InvalidPattern", "^[A-Z]{2}$"), Code: "pattern"},
+				ValidationError{Field: "address.postalCode", Message: defaultMessage("InvalidPattern", "^[A-Za-z0-9][A-Za-z0-9 -]*$"), Code: "pattern"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package jsonValidator
+
+import "reflect"
+
+// validateAggregates checks the sum ("sumField=weight;sumMin=;sumMax=") and the average ("avgField=score;avgMin=;
+// avgMax=") of a member of the elements of a []struct field. The elements without a number in the member are left out,
+// their type was already reported.
+func (s *state) validateAggregates(validations *Validations, fieldName string, valueList []int, parent string) []error {
+
+	// 1) Initialize the errors list.
+	var errors []error
+
+	// 2) Validate the sum.
+	if validations.SumField != "" {
+		sum, _ := s.sum(valueList, validations.SumField)
+		if !reflect.ValueOf(validations.SumMin).IsZero() && sum < validations.SumMin {
+			s.trigger(getFieldName(parent, fieldName), "sumMin")
+			errors = append(errors, ValidationError{
+				Field:   getFieldName(parent, fieldName),
+				Message: s.options.format("InvalidMinSum", getFieldName(parent, fieldName), validations.SumField, validations.SumMin),
+				Code:    "min_sum",
+			})
+		}
+		if !reflect.ValueOf(validations.SumMax).IsZero() && sum > validations.SumMax {
+			s.trigger(getFieldName(parent, fieldName), "sumMax")
+			errors = append(errors, ValidationError{
+				Field:   getFieldName(parent, fieldName),
+				Message: s.options.format("InvalidMaxSum", getFieldName(parent, fieldName), validations.SumField, validations.SumMax),
+				Code:    "max_sum",
+			})
+		}
+	}
+
+	// 3) Validate the average, an empty list has no average.
+	if validations.AvgField != "" {
+		sum, count := s.sum(valueList, validations.AvgField)
+		if count == 0 {
+			return errors
+		}
+		avg := sum / float64(count)
+		if !reflect.ValueOf(validations.AvgMin).IsZero() && avg < validations.AvgMin {
+			s.trigger(getFieldName(parent, fieldName), "avgMin")
+			errors = append(errors, ValidationError{
+				Field:   getFieldName(parent, fieldName),
+				Message: s.options.format("InvalidMinAverage", getFieldName(parent, fieldName), validations.AvgField, validations.AvgMin),
+				Code:    "min_avg",
+			})
+		}
+		if !reflect.ValueOf(validations.AvgMax).IsZero() && avg > validations.AvgMax {
+			s.trigger(getFieldName(parent, fieldName), "avgMax")
+			errors = append(errors, ValidationError{
+				Field:   getFieldName(parent, fieldName),
+				Message: s.options.format("InvalidMaxAverage", getFieldName(parent, fieldName), validations.AvgField, validations.AvgMax),
+				Code:    "max_avg",
+			})
+		}
+	}
+
+	// 4) Return the errors.
+	return errors
+}
+
+// sum returns the sum of the numbers of a member of the object elements, and the count of the summed elements.
+func (s *state) sum(valueList []int, member string) (float64, int) {
+	var sum float64
+	var count int
+	for _, element := range valueList {
+		if node := s.document.member(element, member); node >= 0 && s.document.kind(node) == kindNumber {
+			sum += s.document.numberValue(node)
+			count++
+		}
+	}
+	return sum, count
+}
@@ -0,0 +1,63 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_Aggregates(t *testing.T) {
+	type Parcel struct {
+		Weight *float64 `validations:"type=float"`
+		Score  *int     `validations:"type=int"`
+	}
+	type createObject struct {
+		Parcels []Parcel `validations:"type=[]struct;sumField=weight;sumMin=10;sumMax=100"`
+		Reviews []Parcel `validations:"type=[]struct;avgField=score;avgMin=1;avgMax=5"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_aggregates",
+			jsonData: []byte(`{"parcels": [{"weight": 40}, {"weight": 60}, {}], "reviews": [{"score": 5}, {"score": 2}]}`),
+			want:     nil,
+		},
+		{
+			name:     "test_aggregates_empty",
+			jsonData: []byte(`{"reviews": []}`),
+			want:     nil,
+		},
+		{
+			name:     "test_aggregates_max",
+			jsonData: []byte(`{"parcels": [{"weight": 40}, {"weight": 60.5}], "reviews": [{"score": 6}, {"score": 5}]}`),
+			want: []error{
+				ValidationError{Field: "parcels", Message: defaultMessage("InvalidMaxSum", "weight", 100), Code: "max_sum"},
+				ValidationError{Field: "reviews", Message: defaultMessage("InvalidMaxAverage", "score", 5), Code: "max_avg"},
+			},
+		},
+		{
+			name:     "test_aggregates_min",
+			jsonData: []byte(`{"parcels": [{"weight": 4}], "reviews": [{"score": 0}, {"score": 1}]}`),
+			want: []error{
+				ValidationError{Field: "parcels", Message: defaultMessage("InvalidMinSum", "weight", 10), Code: "min_sum"},
+				ValidationError{Field: "reviews", Message: defaultMessage("InvalidMinAverage", "score", 1), Code: "min_avg"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package jsonValidator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"time"
+)
+
+// AuditRecord is the evidence of a validation: when it happened, the type of the form, the SHA-256 hash of the
+// validated payload (hex encoded) and the errors, nil when the payload was valid.
+type AuditRecord struct {
+	Time        time.Time
+	Form        string
+	PayloadHash string
+	Errors      []error
+}
+
+// AuditSink receives an AuditRecord after each validation of a json payload (Validate, ValidateInto, ValidateReader,
+// each record of ValidateLines, ValidateRequest, ValidateMultipart and the schemas), e.g. to retain the evidence of the
+// input validation of a regulated service. Audit is called synchronously and concurrently, it must not modify the
+// errors.
+type AuditSink interface {
+	Audit(record AuditRecord)
+}
+
+// AuditSinkFunc is an AuditSink calling the function with each record.
+type AuditSinkFunc func(record AuditRecord)
+
+func (f AuditSinkFunc) Audit(record AuditRecord) {
+	f(record)
+}
+
+// DefaultAuditSink receives the records of the validations without the WithAuditSink option. It discards them by
+// default, and should be set once at startup.
+var DefaultAuditSink AuditSink = noAuditSink{}
+
+type noAuditSink struct{}
+
+func (noAuditSink) Audit(AuditRecord) {}
+
+// WithAuditSink sends the AuditRecord of the validation to sink instead of DefaultAuditSink.
+func WithAuditSink(sink AuditSink) Option {
+	return func(o *options) {
+		o.auditSink = sink
+	}
+}
+
+// audit sends the record of a validation of the payload against the form to the audit sink, skipping the hash of the
+// payload when the records are discarded.
+func (o *options) audit(formType reflect.Type, payload []byte, errors []error) {
+
+	// 1) Get the audit sink.
+	sink := o.auditSink
+	if sink == nil {
+		sink = DefaultAuditSink
+	}
+	if _, discarded := sink.(noAuditSink); discarded {
+		return
+	}
+
+	// 2) Send the record.
+	hash := sha256.Sum256(payload)
+	sink.Audit(AuditRecord{
+		Time:        time.Now(),
+		Form:        formType.String(),
+		PayloadHash: hex.EncodeToString(hash[:]),
+		Errors:      errors,
+	})
+}
@@ -0,0 +1,120 @@
+package jsonValidator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// auditRecorder records the audit records it receives.
+type auditRecorder struct {
+	mu      sync.Mutex
+	records []AuditRecord
+}
+
+func (r *auditRecorder) Audit(record AuditRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = append(r.records, record)
+}
+
+func payloadHash(payload string) string {
+	hash := sha256.Sum256([]byte(payload))
+	return hex.EncodeToString(hash[:])
+}
+
+func TestAudit(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string;required=true"`
+		Page *int    `validations:"in=query;type=int"`
+	}
+	tests := []struct {
+		name     string
+		validate func(opts ...Option) []error
+		want     AuditRecord
+	}{
+		{
+			name: "test_audit_valid",
+			validate: func(opts ...Option) []error {
+				return Validate([]byte("{\"name\": \"Daniel\"}"), new(createObject), opts...)
+			},
+			want: AuditRecord{Form: "jsonValidator.createObject", PayloadHash: payloadHash("{\"name\": \"Daniel\"}")},
+		},
+		{
+			name: "test_audit_errors",
+			validate: func(opts ...Option) []error {
+				return Validate([]byte("{}"), new(createObject), opts...)
+			},
+			want: AuditRecord{
+				Form:        "jsonValidator.createObject",
+				PayloadHash: payloadHash("{}"),
+				Errors:      []error{ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"}},
+			},
+		},
+		{
+			name: "test_audit_request",
+			validate: func(opts ...Option) []error {
+				r := httptest.NewRequest(http.MethodPost, "/?page=2", strings.NewReader("{\"name\": \"Daniel\"}"))
+				return ValidateRequest(r, nil, new(createObject), opts...)
+			},
+			want: AuditRecord{Form: "jsonValidator.createObject", PayloadHash: payloadHash("{\"name\": \"Daniel\"}")},
+		},
+		{
+			name: "test_audit_reader_empty_body",
+			validate: func(opts ...Option) []error {
+				return ValidateReader(strings.NewReader(""), new(createObject), opts...)
+			},
+			want: AuditRecord{
+				Form:        "jsonValidator.createObject",
+				PayloadHash: payloadHash(""),
+				Errors:      []error{ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"}},
+			},
+		},
+		{
+			name: "test_audit_reader_too_large",
+			validate: func(opts ...Option) []error {
+				opts = append(opts, WithMaxBytes(4))
+				return ValidateReader(strings.NewReader("{\"name\": \"Daniel\"}"), new(createObject), opts...)
+			},
+			want: AuditRecord{
+				Form:        "jsonValidator.createObject",
+				PayloadHash: payloadHash(""),
+				Errors:      []error{PayloadTooLargeError{Limit: 4}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := new(auditRecorder)
+			errs := tt.validate(WithAuditSink(recorder))
+			if len(recorder.records) != 1 || recorder.records[0].Time.IsZero() {
+				t.Fatalf("Audit() records = %v, want one record", recorder.records)
+			}
+			got := recorder.records[0]
+			got.Time = tt.want.Time
+			if !reflect.DeepEqual(got, tt.want) || !reflect.DeepEqual(errs, tt.want.Errors) {
+				t.Errorf("Audit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultAuditSink(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string"`
+	}
+	var records []AuditRecord
+	DefaultAuditSink = AuditSinkFunc(func(record AuditRecord) {
+		records = append(records, record)
+	})
+	defer func() { DefaultAuditSink = noAuditSink{} }()
+	Validate([]byte("{\"name\": \"Daniel\"}"), new(createObject))
+	if len(records) != 1 || records[0].Form != "jsonValidator.createObject" {
+		t.Errorf("DefaultAuditSink records = %v, want one record", records)
+	}
+}
@@ -0,0 +1,94 @@
+package jsonValidator
+
+// BulkStatus is the status of a record of a bulk payload.
+type BulkStatus string
+
+const (
+	BulkOk      BulkStatus = "ok"
+	BulkInvalid BulkStatus = "invalid"
+)
+
+// BulkRecord is the result of a record of a bulk payload: its index in the array, its status, the form populated with
+// the parsed data when it is valid, and its errors otherwise.
+type BulkRecord struct {
+	Index  int        `json:"index"`
+	Status BulkStatus `json:"status"`
+	Form   any        `json:"-"`
+	Errors []error    `json:"errors,omitempty"`
+}
+
+// BulkResult is the per-record results envelope of a bulk payload, with the count of the valid and invalid records.
+// Errors holds the errors of the whole payload (not an array, too large...), which leave Records empty.
+type BulkResult struct {
+	Records []BulkRecord `json:"records"`
+	Valid   int          `json:"valid"`
+	Invalid int          `json:"invalid"`
+	Errors  []error      `json:"errors,omitempty"`
+}
+
+// ValidateBulk validates each record of an array payload against a new form returned by makeForm (a pointer to a
+// struct), e.g. for the bulk endpoints accepting the valid records and reporting the others. The lines and columns of
+// the errors are those of the payload. WithMaxBytes and WithIntegrityCheck apply to the whole payload.
+func ValidateBulk(jsonData []byte, makeForm func() any, opts ...Option) BulkResult {
+
+	// 1) Check the size and the integrity of the whole payload.
+	o := newOptions(opts)
+	result := BulkResult{Records: []BulkRecord{}}
+	if o.maxBytes > 0 && int64(len(jsonData)) > o.maxBytes {
+		result.Errors = []error{PayloadTooLargeError{Limit: o.maxBytes}}
+		return result
+	}
+	if result.Errors = verifyIntegrity(jsonData, o); result.Errors != nil {
+		return result
+	}
+
+	// 2) Parse the payload, which must be an array.
+	document, err := parseDocument(jsonData)
+	if err != nil || document.kind(0) != kindArray {
+		validationError := ValidationError{
+			Field:   "json",
+			Message: o.format("InvalidFormat", "json", string(jsonData)),
+			Code:    "invalid_json",
+		}
+		if err == nil {
+			validationError.Code = "invalid_type"
+		}
+		if syntaxErr, ok := err.(*syntaxError); ok {
+			validationError.Line, validationError.Column = position(jsonData, syntaxErr.offset)
+		}
+		result.Errors = []error{validationError}
+		return result
+	}
+
+	// 3) Validate each record, with the options of a record.
+	recordOptions := *o
+	recordOptions.maxBytes, recordOptions.integrityCheck = 0, nil
+	for index, elementNode := range document.elements(0) {
+		record := BulkRecord{Index: index, Status: BulkOk, Form: makeForm()}
+		record.Errors = validate(document.raw(elementNode), record.Form, &recordOptions)
+
+		// 3.1) Report the errors at their position in the payload.
+		line, column := position(jsonData, document.nodes[elementNode].start)
+		for i, recordError := range record.Errors {
+			if validationError, ok := recordError.(ValidationError); ok && validationError.Line > 0 {
+				if validationError.Line == 1 {
+					validationError.Column += column - 1
+				}
+				validationError.Line += line - 1
+				record.Errors[i] = validationError
+			}
+		}
+
+		// 3.2) Count the record.
+		if record.Errors != nil {
+			record.Status, record.Form = BulkInvalid, nil
+			result.Invalid++
+		} else {
+			result.Valid++
+		}
+		result.Records = append(result.Records, record)
+	}
+
+	// 4) Return the results.
+	return result
+}
@@ -0,0 +1,99 @@
+package jsonValidator
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidateBulk(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string;required=true"`
+		Age  *int    `validations:"type=int;min=18"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		opts     []Option
+		want     BulkResult
+	}{
+		{
+			name:     "test_bulk",
+			jsonData: []byte("[\n  {\"name\": \"Daniel\", \"age\": 26},\n  {\"age\": 10},\n  {\"name\": []}\n]"),
+			want: BulkResult{
+				Records: []BulkRecord{
+					{Index: 0, Status: BulkOk, Form: &createObject{Name: toStringPointer("Daniel"), Age: toIntPointer(26)}},
+					{Index: 1, Status: BulkInvalid, Errors: []error{
+						ValidationError{Field: "age", Message: defaultMessage("InvalidMinNumber", 18), Code: "min"},
+						ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"},
+					}},
+					{Index: 2, Status: BulkInvalid, Errors: []error{
+						ValidationError{Field: "name", Message: defaultMessage("InvalidFormat", []any{}), Code: "invalid_type", Line: 4, Column: 12},
+					}},
+				},
+				Valid:   1,
+				Invalid: 2,
+			},
+		},
+		{
+			name:     "test_bulk_empty",
+			jsonData: []byte("[]"),
+			want:     BulkResult{Records: []BulkRecord{}},
+		},
+		{
+			name:     "test_bulk_not_array",
+			jsonData: []byte("{\"name\": \"Daniel\"}"),
+			want: BulkResult{Records: []BulkRecord{}, Errors: []error{
+				ValidationError{Field: "json", Message: defaultMessage("InvalidFormat", "{\"name\": \"Daniel\"}"), Code: "invalid_type"},
+			}},
+		},
+		{
+			name:     "test_bulk_max_bytes",
+			jsonData: []byte("[{\"name\": \"Daniel\"}, {\"name\": \"Silva\"}]"),
+			opts:     []Option{WithMaxBytes(30)},
+			want:     BulkResult{Records: []BulkRecord{}, Errors: []error{PayloadTooLargeError{Limit: 30}}},
+		},
+		{
+			name:     "test_bulk_max_bytes_of_payload",
+			jsonData: []byte("[{\"name\": \"Daniel\"}, {\"name\": \"Silva\"}]"),
+			opts:     []Option{WithMaxBytes(40)},
+			want: BulkResult{
+				Records: []BulkRecord{
+					{Index: 0, Status: BulkOk, Form: &createObject{Name: toStringPointer("Daniel")}},
+					{Index: 1, Status: BulkOk, Form: &createObject{Name: toStringPointer("Silva")}},
+				},
+				Valid: 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateBulk(tt.jsonData, func() any { return new(createObject) }, tt.opts...)
+
+			// Sort
+			for _, record := range got.Records {
+				sort.Sort(Errors(record.Errors))
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBulk() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBulkResult_MarshalJSON(t *testing.T) {
+	result := BulkResult{
+		Records: []BulkRecord{
+			{Index: 0, Status: BulkOk, Form: &struct{}{}},
+			{Index: 1, Status: BulkInvalid, Errors: []error{ValidationError{Field: "name", Message: "required", Code: "required"}}},
+		},
+		Valid:   1,
+		Invalid: 1,
+	}
+	want := "{\"records\":[{\"index\":0,\"status\":\"ok\"},{\"index\":1,\"status\":\"invalid\",\"errors\":[{\"field\":\"name\",\"code\":\"required\",\"message\":\"required\"}]}],\"valid\":1,\"invalid\":1}"
+	if got, err := json.Marshal(result); err != nil || string(got) != want {
+		t.Errorf("json.Marshal() = %s, %v, want %s", got, err, want)
+	}
+}
@@ -0,0 +1,10 @@
+package jsonValidator
+
+// Canonicalize re-serializes a validated form into its canonical JSON. Only the fields declared in the form
+// are emitted (unknown fields are dropped), the values are the bound ones (coercions normalized) and absent
+// fields are omitted. It is Marshal under the name of the canonical form: the values are written with the tag syntax
+// of the options, so the canonical JSON validates into the same form. The output is deterministic (the keys of the
+// form objects are sorted), and follows RFC 8785 with the WithCanonicalJSON option.
+func Canonicalize(form any, opts ...Option) ([]byte, error) {
+	return marshalForm("Canonicalize", form, opts)
+}
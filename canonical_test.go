@@ -0,0 +1,113 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCanonicalize(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string"`
+		Age  *int    `validations:"type=int"`
+	}
+	type createObject struct {
+		Name       *string  `validations:"type=string"`
+		Code       *int     `validations:"type=int"`
+		Successful *bool    `validations:"type=bool"`
+		Owners     []string `validations:"type=[]string"`
+		Person     *Person  `validations:"type=struct"`
+		PersonList []Person `validations:"type=[]struct"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     string
+	}{
+		{
+			name:     "test_canonicalize",
+			jsonData: []byte("{\"name\": \"Daniel\", \"code\": \"123\", \"successful\": \"true\", \"owners\": [1, \"a\", 1], \"person\": {\"age\": \"26\"}, \"personList\": [{\"name\": \"Silva\"}]}"),
+			want:     "{\"code\":123,\"name\":\"Daniel\",\"owners\":[\"1\",\"a\"],\"person\":{\"age\":26},\"personList\":[{\"name\":\"Silva\"}],\"successful\":true}",
+		},
+		{
+			name:     "test_canonicalize_absent_fields",
+			jsonData: []byte("{\"name\": \"Daniel\"}"),
+			want:     "{\"name\":\"Daniel\"}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			if errs := Validate(tt.jsonData, form); errs != nil {
+				t.Fatalf("Validate() = %v, want nil", errs)
+			}
+			got, err := Canonicalize(form)
+			if err != nil {
+				t.Fatalf("Canonicalize() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Canonicalize() = %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalize_RoundTrip(t *testing.T) {
+	type Person struct {
+		Name *string  `v:"type=string"`
+		Kids []Person `v:"type=[]struct"`
+	}
+	type createObject struct {
+		Name     *string           `v:"type=string;required=true"`
+		Birthday *time.Time        `v:"type=datetime;format=2006-01-02"`
+		Sort     []SortTerm        `v:"type=string;format=sortexpr"`
+		Filter   []FilterTerm      `v:"type=string;format=filterexpr"`
+		Teams    map[string]Person `v:"type=map[string]struct"`
+		Person   *Person           `v:"type=struct"`
+	}
+	jsonData := []byte(`{"name": "Daniel", "birthday": "2024-05-01", "sort": "-a,b", "filter": "status:eq:active",
+		"teams": {"b": {"name": "c"}}, "person": {"name": "Ana", "kids": [{"name": "Rui"}]}}`)
+	want := `{"birthday":"2024-05-01","filter":"status:eq:active","name":"Daniel","person":{"kids":[{"name":"Rui"}],"name":"Ana"},` +
+		`"sort":"-a,b","teams":{"b":{"name":"c"}}}`
+	opts := []Option{WithTagName("v")}
+
+	form := new(createObject)
+	if errs := Validate(jsonData, form, opts...); errs != nil {
+		t.Fatalf("Validate() = %v, want nil", errs)
+	}
+	got, err := Canonicalize(form, opts...)
+	if err != nil {
+		t.Fatalf("Canonicalize() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Canonicalize() = %s, want %s", got, want)
+	}
+	roundTrip := new(createObject)
+	if errs := Validate(got, roundTrip, opts...); errs != nil {
+		t.Fatalf("Validate() of the canonical json = %v, want nil", errs)
+	}
+	if !reflect.DeepEqual(roundTrip, form) {
+		t.Errorf("Validate() of the canonical json = %+v, want %+v", roundTrip, form)
+	}
+}
+
+func TestCanonicalize_Marshal(t *testing.T) {
+	form := &struct {
+		Name     *string    `validations:"type=string"`
+		Birthday *time.Time `validations:"type=datetime;format=2006-01-02"`
+	}{}
+	if errs := Validate([]byte(`{"name": "Daniel", "birthday": "2024-05-01"}`), form); errs != nil {
+		t.Fatalf("Validate() = %v, want nil", errs)
+	}
+	canonical, err := Canonicalize(form)
+	if err != nil {
+		t.Fatalf("Canonicalize() error = %v", err)
+	}
+	marshaled, err := Marshal(form)
+	if err != nil || string(canonical) != string(marshaled) {
+		t.Errorf("Canonicalize() = %s, want the Marshal() output %s, %v", canonical, marshaled, err)
+	}
+	if _, err := Canonicalize("form"); err == nil || err.Error() != "jsonValidator: Canonicalize expects a struct or a pointer to a struct" {
+		t.Errorf("Canonicalize() error = %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+package jsonValidator
+
+import (
+	"strings"
+)
+
+// Checksum reports whether the check digit of the value is valid, using the weights of the rule (nil when the rule
+// has no weights).
+type Checksum func(value string, weights []int) bool
+
+// Checksums holds the check digit algorithms available to the "checksum=" validation, indexed by name. The built-in
+// ones are "mod10", "mod11", "mod97" and "verhoeff".
+var Checksums = map[string]Checksum{
+	"mod10":    mod10,
+	"mod11":    mod11,
+	"mod97":    mod97,
+	"verhoeff": verhoeff,
+}
+
+// RegisterChecksum registers a check digit algorithm under the given name so it can be used as "checksum=name" (or
+// "checksum=name:weights" to pass the weights, e.g. "checksum=mod11:3,7,6,1,8,9,4,5,2").
+func RegisterChecksum(name string, checksum Checksum) {
+	Checksums[name] = checksum
+}
+
+// validateChecksum checks the check digit of the value with the algorithm of the "checksum" rule, the unknown
+// algorithms are ignored.
+func (s *state) validateChecksum(validations *Validations, fieldName string, value string) []error {
+	checksum, ok := Checksums[validations.Checksum]
+	if !ok || checksum(value, validations.ChecksumWeights) {
+		return nil
+	}
+	s.trigger(fieldName, "checksum")
+	return []error{ValidationError{
+		Field:   fieldName,
+		Message: s.options.format("InvalidChecksum", fieldName),
+		Code:    "checksum",
+	}}
+}
+
+// splitCheckDigit splits the value into its payload digits and its last character, the check digit. It reports false
+// when the payload has a character that is not a digit.
+func splitCheckDigit(value string) ([]int, byte, bool) {
+	if len(value) < 2 {
+		return nil, 0, false
+	}
+	payload := make([]int, len(value)-1)
+	for i := range payload {
+		if value[i] < '0' || value[i] > '9' {
+			return nil, 0, false
+		}
+		payload[i] = int(value[i] - '0')
+	}
+	return payload, value[len(value)-1], true
+}
+
+// weightedSum returns the sum of the payload digits multiplied by the weights, applied from the left and repeated
+// when the payload is longer than the weights.
+func weightedSum(payload []int, weights []int) int {
+	var sum int
+	for i, digit := range payload {
+		sum += digit * weights[i%len(weights)]
+	}
+	return sum
+}
+
+// mod10 checks the Luhn check digit (credit cards, IMEI), or the weighted modulus 10 check digit when the rule has
+// weights: the check digit makes the weighted sum a multiple of 10.
+func mod10(value string, weights []int) bool {
+
+	// 1) Split the check digit.
+	payload, checkDigit, ok := splitCheckDigit(value)
+	if !ok || checkDigit < '0' || checkDigit > '9' {
+		return false
+	}
+
+	// 2) Compute the weighted sum, doubling every second digit from the right for Luhn.
+	var sum int
+	if weights != nil {
+		sum = weightedSum(payload, weights)
+	} else {
+		for i := range payload {
+			digit := payload[len(payload)-1-i]
+			if i%2 == 0 {
+				digit *= 2
+				if digit > 9 {
+					digit -= 9
+				}
+			}
+			sum += digit
+		}
+	}
+
+	// 3) Compare the check digit.
+	return int(checkDigit-'0') == (10-sum%10)%10
+}
+
+// mod11 checks the modulus 11 check digit, the one that makes the weighted sum of the whole value a multiple of 11.
+// Without weights, the payload digits are weighted 2, 3, 4... from the right (ISBN-10). A check digit of 10 is
+// written "X".
+func mod11(value string, weights []int) bool {
+
+	// 1) Split the check digit.
+	payload, checkDigit, ok := splitCheckDigit(value)
+	if !ok {
+		return false
+	}
+
+	// 2) Compute the weighted sum.
+	var sum int
+	if weights != nil {
+		sum = weightedSum(payload, weights)
+	} else {
+		for i := range payload {
+			sum += payload[len(payload)-1-i] * (i + 2)
+		}
+	}
+
+	// 3) Compare the check digit.
+	switch expected := (11 - sum%11) % 11; {
+	case expected == 10:
+		return checkDigit == 'X' || checkDigit == 'x'
+	default:
+		return int(checkDigit) == '0'+expected
+	}
+}
+
+// mod97 checks the ISO 7064 MOD 97-10 check digits (IBAN, LEI): the value, with the letters replaced by 10 to 35,
+// must be 1 modulo 97. The weights are ignored.
+func mod97(value string, _ []int) bool {
+	if len(value) < 3 {
+		return false
+	}
+	var remainder int
+	for _, char := range strings.ToUpper(value) {
+		switch {
+		case char >= '0' && char <= '9':
+			remainder = (remainder*10 + int(char-'0')) % 97
+		case char >= 'A' && char <= 'Z':
+			remainder = (remainder*100 + int(char-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+// verhoeffMultiplication and verhoeffPermutation are the dihedral group D5 tables of the Verhoeff algorithm.
+var verhoeffMultiplication = [10][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+	{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+	{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+	{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+	{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+	{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+	{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+	{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+	{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+}
+
+var verhoeffPermutation = [8][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+	{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+	{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+	{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+	{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+	{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+	{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+}
+
+// verhoeff checks the Verhoeff check digit (Aadhaar), which detects every single digit error and every transposition
+// of adjacent digits. The weights are ignored.
+func verhoeff(value string, _ []int) bool {
+	if len(value) < 2 {
+		return false
+	}
+	var check int
+	for i := 0; i < len(value); i++ {
+		char := value[len(value)-1-i]
+		if char < '0' || char > '9' {
+			return false
+		}
+		check = verhoeffMultiplication[check][verhoeffPermutation[i%8][char-'0']]
+	}
+	return check == 0
+}
@@ -0,0 +1,88 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_Checksum(t *testing.T) {
+	type createObject struct {
+		Card       *string  `validations:"type=string;format=digits;checksum=mod10"`
+		NationalId *string  `validations:"type=string;format=digits;checksum=mod11:3,7,6,1,8,9,4,5,2"`
+		Isbn       *string  `validations:"type=string;checksum=mod11"`
+		Lei        *string  `validations:"type=string;checksum=mod97"`
+		Aadhaar    *string  `validations:"type=string;checksum=verhoeff"`
+		Cards      []string `validations:"type=[]string;checksum=mod10"`
+		Unknown    *string  `validations:"type=string;checksum=unknown"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_checksum",
+			jsonData: []byte(`{"card": "4111111111111111", "nationalId": "1508751239", "isbn": "080442957X", "lei": "5493001KJTIIGC8Y1R12", "aadhaar": "2363", "cards": ["79927398713"], "unknown": "1"}`),
+			want:     nil,
+		},
+		{
+			name:     "test_checksum_invalid",
+			jsonData: []byte(`{"card": "4111111111111112", "nationalId": "1508751237", "isbn": "0306406153", "lei": "5493001KJTIIGC8Y1R13", "aadhaar": "2364", "cards": ["79927398713", "79927398710"]}`),
+			want: []error{
+				ValidationError{Field: "card", Message: DefaultMessages["InvalidChecksum"], Code: "checksum"},
+				ValidationError{Field: "nationalId", Message: DefaultMessages["InvalidChecksum"], Code: "checksum"},
+				ValidationError{Field: "isbn", Message: DefaultMessages["InvalidChecksum"], Code: "checksum"},
+				ValidationError{Field: "lei", Message: DefaultMessages["InvalidChecksum"], Code: "checksum"},
+				ValidationError{Field: "aadhaar", Message: DefaultMessages["InvalidChecksum"], Code: "checksum"},
+				ValidationError{Field: "cards[1]", Message: DefaultMessages["InvalidChecksum"], Code: "checksum"},
+			},
+		},
+		{
+			name:     "test_checksum_not_digits",
+			jsonData: []byte(`{"isbn": "03064A6152", "aadhaar": "23-63"}`),
+			want: []error{
+				ValidationError{Field: "isbn", Message: DefaultMessages["InvalidChecksum"], Code: "checksum"},
+				ValidationError{Field: "aadhaar", Message: DefaultMessages["InvalidChecksum"], Code: "checksum"},
+			},
+		},
+		{
+			name:     "test_checksum_invalid_format",
+			jsonData: []byte(`{"card": "4111-1111"}`),
+			want: []error{
+				ValidationError{Field: "card", Message: DefaultMessages["InvalidDigits"], Code: "format"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterChecksum(t *testing.T) {
+	type createObject struct {
+		Code *string `validations:"type=string;checksum=even:2"`
+	}
+	RegisterChecksum("even", func(value string, weights []int) bool {
+		return len(value)%weights[0] == 0
+	})
+	defer delete(Checksums, "even")
+
+	if got := Validate([]byte(`{"code": "ab"}`), new(createObject)); got != nil {
+		t.Errorf("Validate() = %v, want nil", got)
+	}
+	want := []error{ValidationError{Field: "code", Message: DefaultMessages["InvalidChecksum"], Code: "checksum"}}
+	if got := Validate([]byte(`{"code": "abc"}`), new(createObject)); !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate() = %v, want %v", got, want)
+	}
+}
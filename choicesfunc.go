@@ -0,0 +1,69 @@
+package jsonValidator
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// choicesProvider is a provider registered for the "choicesFunc=" validation, with its cached choices.
+type choicesProvider struct {
+	provider func() ([]any, error)
+	ttl      time.Duration
+
+	mu      sync.Mutex
+	choices []any
+	expires time.Time
+}
+
+// choicesProviders holds the providers available to the "choicesFunc=" validation, indexed by name.
+var choicesProviders = map[string]*choicesProvider{}
+
+// RegisterChoices registers a provider of choices under the given name so it can be used as "choicesFunc=name", e.g.
+// the ids of the active plans read from a database. The provider returns the choices of the type of the field (strings,
+// ints or float64s). With a ttl bigger than 0 the choices are cached for the ttl, otherwise the provider is called at
+// each validation of the field. The providers should be registered once at startup.
+func RegisterChoices(name string, provider func() ([]any, error), ttl time.Duration) {
+	choicesProviders[name] = &choicesProvider{provider: provider, ttl: ttl}
+}
+
+// get returns the choices of the provider, from the cache while they have not expired.
+func (cp *choicesProvider) get() ([]any, error) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if cp.ttl > 0 && cp.choices != nil && time.Now().Before(cp.expires) {
+		return cp.choices, nil
+	}
+	choices, err := cp.provider()
+	if err != nil {
+		return nil, err
+	}
+	if choices == nil {
+		choices = []any{}
+	}
+	cp.choices, cp.expires = choices, time.Now().Add(cp.ttl)
+	return choices, nil
+}
+
+// resolveChoices returns the validations with the choices of their "choicesFunc=" provider, or the validations
+// themselves without it. A provider that is not registered is a ConfigError, and a failing provider returns its error.
+func resolveChoices(validations *Validations) (*Validations, error) {
+
+	// 1) Get the provider.
+	if validations.ChoicesFunc == "" {
+		return validations, nil
+	}
+	provider, ok := choicesProviders[validations.ChoicesFunc]
+	if !ok {
+		return nil, ConfigError{Message: fmt.Sprintf("no choices provider is registered as %s", validations.ChoicesFunc)}
+	}
+
+	// 2) Get the choices, into a copy of the shared validations.
+	choices, err := provider.get()
+	if err != nil {
+		return nil, fmt.Errorf("jsonValidator: choices provider %s: %w", validations.ChoicesFunc, err)
+	}
+	resolved := *validations
+	resolved.Choices, resolved.ChoiceLabels = choices, nil
+	return &resolved, nil
+}
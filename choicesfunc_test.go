@@ -0,0 +1,106 @@
+package jsonValidator
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestChoicesFunc(t *testing.T) {
+	RegisterChoices("planIds", func() ([]any, error) { return []any{"basic", "pro"}, nil }, 0)
+	RegisterChoices("regionIds", func() ([]any, error) { return []any{1, 2}, nil }, 0)
+	RegisterChoices("failing", func() ([]any, error) { return nil, errors.New("database is down") }, 0)
+	type createObject struct {
+		Plan    *string  `validations:"type=string;choicesFunc=planIds"`
+		Regions []int    `validations:"type=[]int;choicesFunc=regionIds"`
+		Backup  *string  `validations:"type=string;choicesFunc=failing"`
+		Legacy  *float64 `validations:"type=float;choicesFunc=unregistered"`
+	}
+	tests := []struct {
+		name     string
+		jsonData string
+		want     []error
+	}{
+		{
+			name:     "test_choices_func",
+			jsonData: `{"plan": "pro", "regions": [1, 2]}`,
+			want:     nil,
+		},
+		{
+			name:     "test_choices_func_errors",
+			jsonData: `{"plan": "enterprise", "regions": [1, 3]}`,
+			want: []error{
+				ValidationError{Field: "plan", Message: defaultMessage("InvalidChoice", "enterprise", []any{"basic", "pro"}), Code: "choice"},
+				ValidationError{Field: "regions[1]", Message: defaultMessage("InvalidChoice", 3, []any{1, 2}), Code: "choice"},
+			},
+		},
+		{
+			name:     "test_choices_func_unregistered",
+			jsonData: `{"legacy": 1.5}`,
+			want:     []error{ConfigError{Message: "no choices provider is registered as unregistered"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate([]byte(tt.jsonData), new(createObject))
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// The errors of the providers are internal errors.
+	got := Validate([]byte(`{"backup": "daily"}`), new(createObject))
+	if len(got) != 1 || Classify(got[0]) != ClassInternal {
+		t.Errorf("Validate() = %v, want an internal error", got)
+	}
+}
+
+func TestChoicesFunc_TTL(t *testing.T) {
+	calls := 0
+	RegisterChoices("cachedPlanIds", func() ([]any, error) {
+		calls++
+		return []any{"basic"}, nil
+	}, time.Hour)
+	RegisterChoices("uncachedPlanIds", func() ([]any, error) {
+		calls++
+		return []any{"basic"}, nil
+	}, 0)
+	tests := []struct {
+		name      string
+		form      any
+		wantCalls int
+	}{
+		{
+			name: "test_ttl_cached",
+			form: new(struct {
+				Plan *string `validations:"type=string;choicesFunc=cachedPlanIds"`
+			}),
+			wantCalls: 1,
+		},
+		{
+			name: "test_ttl_uncached",
+			form: new(struct {
+				Plan *string `validations:"type=string;choicesFunc=uncachedPlanIds"`
+			}),
+			wantCalls: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = 0
+			for i := 0; i < 3; i++ {
+				if errs := Validate([]byte(`{"plan": "basic"}`), tt.form); errs != nil {
+					t.Fatalf("Validate() = %v, want nil", errs)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+}
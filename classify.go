@@ -0,0 +1,75 @@
+package jsonValidator
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+// ErrorClass tells who can fix an error: the client sending the payload, or the service validating it.
+type ErrorClass string
+
+const (
+	// ClassClient is the class of the errors of the payload (a ValidationError, a PayloadTooLargeError or an
+	// IntegrityError), fixed by the client and answered with a 4xx.
+	ClassClient ErrorClass = "client"
+	// ClassConfiguration is the class of the errors of the use of the package (a ConfigError), e.g. a form that is not
+	// a pointer to a struct, fixed by the service and answered with a 500.
+	ClassConfiguration ErrorClass = "configuration"
+	// ClassInternal is the class of the other errors, e.g. the failures of the readers of the body, answered with a 500.
+	ClassInternal ErrorClass = "internal"
+)
+
+// ConfigError is returned when the package is not used correctly, instead of panicking, e.g. when the form is not a
+// pointer to a struct.
+type ConfigError struct {
+	Message string
+}
+
+func (ce ConfigError) Error() string {
+	return "jsonValidator: " + ce.Message
+}
+
+// Classify returns the class of an error returned by a validation.
+func Classify(err error) ErrorClass {
+	var validationError ValidationError
+	var payloadTooLargeError PayloadTooLargeError
+	var integrityError IntegrityError
+	var maxBytesError *http.MaxBytesError
+	var configError ConfigError
+	switch {
+	case errors.As(err, &validationError), errors.As(err, &payloadTooLargeError), errors.As(err, &integrityError),
+		errors.As(err, &maxBytesError):
+		return ClassClient
+	case errors.As(err, &configError):
+		return ClassConfiguration
+	default:
+		return ClassInternal
+	}
+}
+
+// Class returns the class of the errors: ClassConfiguration or ClassInternal when any of them is of that class (in
+// this order), so the errors that the client cannot fix are not answered as its own, and ClassClient otherwise.
+func (ve ValidationErrors) Class() ErrorClass {
+	class := ClassClient
+	for _, err := range ve {
+		switch Classify(err) {
+		case ClassConfiguration:
+			return ClassConfiguration
+		case ClassInternal:
+			class = ClassInternal
+		}
+	}
+	return class
+}
+
+// formValueOf returns the struct pointed by the form, or a ConfigError when the form is not a non-nil pointer to a
+// struct.
+func formValueOf(form any) (reflect.Value, error) {
+	formValue := reflect.ValueOf(form)
+	if formValue.Kind() != reflect.Pointer || formValue.IsNil() || formValue.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, ConfigError{Message: fmt.Sprintf("the form must be a non-nil pointer to a struct, got %T", form)}
+	}
+	return formValue.Elem(), nil
+}
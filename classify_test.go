@@ -0,0 +1,91 @@
+package jsonValidator
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		errs ValidationErrors
+		want ErrorClass
+	}{
+		{
+			name: "test_classify_client",
+			errs: ValidationErrors{
+				ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				PayloadTooLargeError{Limit: 10},
+				IntegrityError{Err: errors.New("invalid signature")},
+				&http.MaxBytesError{Limit: 10},
+			},
+			want: ClassClient,
+		},
+		{
+			name: "test_classify_empty",
+			want: ClassClient,
+		},
+		{
+			name: "test_classify_internal",
+			errs: ValidationErrors{ValidationError{Field: "name"}, errors.New("connection reset")},
+			want: ClassInternal,
+		},
+		{
+			name: "test_classify_configuration",
+			errs: ValidationErrors{errors.New("connection reset"), fmt.Errorf("wrapped: %w", ConfigError{Message: "invalid form"})},
+			want: ClassConfiguration,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.errs.Class(); got != tt.want {
+				t.Errorf("Class() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_ConfigError(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string"`
+	}
+	var nilForm *createObject
+	tests := []struct {
+		name string
+		form any
+		want []error
+	}{
+		{
+			name: "test_struct_value",
+			form: createObject{},
+			want: []error{ConfigError{Message: "the form must be a non-nil pointer to a struct, got jsonValidator.createObject"}},
+		},
+		{
+			name: "test_nil_pointer",
+			form: nilForm,
+			want: []error{ConfigError{Message: "the form must be a non-nil pointer to a struct, got *jsonValidator.createObject"}},
+		},
+		{
+			name: "test_pointer_to_map",
+			form: &map[string]any{},
+			want: []error{ConfigError{Message: "the form must be a non-nil pointer to a struct, got *map[string]interface {}"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate([]byte("{\"name\": \"Daniel\"}"), tt.form)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			if problem := NewProblemDetails(got); problem.Status != http.StatusInternalServerError || len(problem.Errors) != 0 {
+				t.Errorf("NewProblemDetails() = %v, want a 500 without errors", problem)
+			}
+			if problem := NewInvalidParamsProblem(got); problem.Status != http.StatusInternalServerError {
+				t.Errorf("NewInvalidParamsProblem() = %v, want a 500", problem)
+			}
+		})
+	}
+}
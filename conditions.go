@@ -0,0 +1,64 @@
+package jsonValidator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Condition is a condition on the value of another field of the same object, used by the conditional rules
+// (e.g. "required_if=paymentType:card"). A condition without values only checks that the field was received.
+type Condition struct {
+	Field  string
+	Values []string
+}
+
+// parseCondition parses a "field:value1,value2" condition.
+func parseCondition(value string, syntax tagSyntax) *Condition {
+	field, values, found := strings.Cut(value, syntax.choiceLabelSeparator)
+	if field == "" {
+		return nil
+	}
+	condition := &Condition{Field: field}
+	if found && values != "" {
+		condition.Values = strings.Split(values, syntax.choicesSeparator)
+	}
+	return condition
+}
+
+// matches reports whether the condition field of the object node was received (not null) with one of the values.
+func (s *state) matches(objectNode int, condition *Condition) bool {
+	node := s.document.member(objectNode, condition.Field)
+	if node < 0 || s.document.kind(node) == kindNull {
+		return false
+	}
+	if condition.Values == nil {
+		return true
+	}
+	value := fmt.Sprintf("%v", s.document.value(node))
+	for _, conditionValue := range condition.Values {
+		if conditionValue == value {
+			return true
+		}
+	}
+	return false
+}
+
+// requiredRule returns the rule requiring a field of the object node that was not received ("required",
+// "required_if", "required_unless" or "required_with"), or an empty string if the field is not required. The
+// conditions are evaluated against the whole object, once all its members were validated.
+func (s *state) requiredRule(objectNode int, validations *Validations) string {
+	switch {
+	case validations.Required:
+		return "required"
+	case validations.RequiredIf != nil && s.matches(objectNode, validations.RequiredIf):
+		return "required_if"
+	case validations.RequiredUnless != nil && !s.matches(objectNode, validations.RequiredUnless):
+		return "required_unless"
+	}
+	for _, field := range validations.RequiredWith {
+		if s.matches(objectNode, &Condition{Field: field}) {
+			return "required_with"
+		}
+	}
+	return ""
+}
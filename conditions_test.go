@@ -0,0 +1,58 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_ConditionalRequirements(t *testing.T) {
+	type createObject struct {
+		PaymentType *string `validations:"type=string;required=true;choices=card,paypal,transfer"`
+		CardNumber  *string `validations:"type=string;required_if=paymentType:card"`
+		Email       *string `validations:"type=string;required_unless=paymentType:card,transfer"`
+		Iban        *string `validations:"type=string"`
+		Bic         *string `validations:"type=string;required_with=iban"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_conditions_met",
+			jsonData: []byte(`{"paymentType": "card", "cardNumber": "4111111111111111"}`),
+			want:     nil,
+		},
+		{
+			name:     "test_required_if",
+			jsonData: []byte(`{"paymentType": "card"}`),
+			want: []error{
+				ValidationError{Field: "cardNumber", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+		{
+			name:     "test_required_unless",
+			jsonData: []byte(`{"paymentType": "paypal"}`),
+			want: []error{
+				ValidationError{Field: "email", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+		{
+			name:     "test_required_with",
+			jsonData: []byte(`{"paymentType": "transfer", "iban": "ES9121000418450200051332"}`),
+			want: []error{
+				ValidationError{Field: "bic", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
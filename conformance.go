@@ -0,0 +1,284 @@
+package jsonValidator
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ConformanceCase is a payload together with the errors the form produces for it.
+type ConformanceCase struct {
+	Name    string             `json:"name"`
+	Payload json.RawMessage    `json:"payload"`
+	Errors  []ConformanceError `json:"errors"`
+}
+
+// ConformanceError is an expected error of a ConformanceCase.
+type ConformanceError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// conformanceVariant is a variation of the value of a field, or its removal.
+type conformanceVariant struct {
+	name   string
+	value  any
+	remove bool
+}
+
+// GenerateConformanceCases generates the conformance cases of a form: a valid payload, and a payload for each rule
+// boundary (min-1, min, max, max+1), each choice, an invalid choice, a wrong type and each missing required field.
+// The expected errors are the ones produced by this package, and the cases can be exported as JSON so other
+// implementations of the same contract can run them.
+func GenerateConformanceCases(form any) []ConformanceCase {
+
+	// 1) Get the form type.
+	formType := structType(reflect.TypeOf(form))
+
+	// 2) Build the valid payload and its variants.
+	base := conformanceBase(formType)
+	variants := append([]conformanceVariant{{name: "valid", value: base}}, conformanceObjectVariants(formType, base, "")...)
+
+	// 3) Validate each payload to get the expected errors.
+	cases := make([]ConformanceCase, 0, len(variants))
+	for _, variant := range variants {
+		payload, _ := json.Marshal(variant.value)
+		conformanceCase := ConformanceCase{Name: variant.name, Payload: payload, Errors: []ConformanceError{}}
+		for _, err := range Validate(payload, reflect.New(formType).Interface()) {
+			var validationError ValidationError
+			if errors.As(err, &validationError) {
+				conformanceCase.Errors = append(conformanceCase.Errors, ConformanceError{Field: validationError.Field, Message: validationError.Message})
+			}
+		}
+		sort.Slice(conformanceCase.Errors, func(i, j int) bool {
+			return conformanceCase.Errors[i].Field < conformanceCase.Errors[j].Field
+		})
+		cases = append(cases, conformanceCase)
+	}
+
+	// 4) Return the cases.
+	return cases
+}
+
+// conformanceBase builds a valid payload of a form type.
+// The fields of a recursive form that hold one of its enclosing forms are left out.
+func conformanceBase(formType reflect.Type, ancestors ...reflect.Type) map[string]any {
+	base := make(map[string]any)
+	if formType.Kind() != reflect.Struct {
+		return base
+	}
+	ancestors = append(ancestors, formType)
+	for fieldName, validations := range getValidations(reflect.New(formType).Elem(), defaultSyntax()) {
+		if value, ok := conformanceValue(formType, validations, ancestors); ok {
+			base[fieldName] = value
+		}
+	}
+	return base
+}
+
+// conformanceValue returns a valid value for the validations of a field.
+func conformanceValue(formType reflect.Type, validations *Validations, ancestors []reflect.Type) (any, bool) {
+	element := strings.TrimPrefix(validations.Type, "[]")
+	var value any
+	switch {
+	case validations.Choices != nil:
+		value = validations.Choices[0]
+	case element == "string" && validations.Len != 0 && !strings.HasPrefix(validations.Type, "[]"):
+		value = strings.Repeat("a", validations.Len)
+	case element == "string":
+		value = strings.Repeat("a", int(validations.Min))
+	case element == "int", element == "float":
+		value = validations.Min
+	case element == "bool":
+		value = true
+	case element == "struct":
+		field, _ := formType.FieldByName(validations.structField)
+		if containsType(ancestors, structType(field.Type)) {
+			return nil, false
+		}
+		value = conformanceBase(structType(field.Type), ancestors...)
+	default:
+		return nil, false
+	}
+	if !strings.HasPrefix(validations.Type, "[]") {
+		return value, true
+	}
+	count := int(validations.Min)
+	if validations.Len != 0 {
+		count = validations.Len
+	}
+	if count == 0 {
+		count = 1
+	}
+	list := make([]any, count)
+	for i := range list {
+		list[i] = value
+	}
+	return list, true
+}
+
+// conformanceObjectVariants returns the variants of each field of an object, the variants of the inner objects are
+// applied on the base value of their field.
+func conformanceObjectVariants(formType reflect.Type, base map[string]any, parent string) []conformanceVariant {
+
+	// 1) Iterate over the fields in a stable order, the interface fields have no declared fields.
+	if formType.Kind() != reflect.Struct {
+		return nil
+	}
+	validationsMap := getValidations(reflect.New(formType).Elem(), defaultSyntax())
+	fieldNames := make([]string, 0, len(validationsMap))
+	for fieldName := range validationsMap {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	// 2) Apply the variants of each field on a copy of the base object.
+	var variants []conformanceVariant
+	for _, fieldName := range fieldNames {
+
+		// 2.1) The recursive fields have no base value to vary.
+		if _, ok := base[fieldName]; !ok && strings.TrimPrefix(validationsMap[fieldName].Type, "[]") == "struct" {
+			continue
+		}
+		for _, variant := range conformanceFieldVariants(formType, validationsMap[fieldName], base[fieldName], getFieldName(parent, fieldName)) {
+			object := make(map[string]any, len(base))
+			for k, v := range base {
+				object[k] = v
+			}
+			if variant.remove {
+				delete(object, fieldName)
+			} else {
+				object[fieldName] = variant.value
+			}
+			variants = append(variants, conformanceVariant{name: variant.name, value: object})
+		}
+	}
+
+	// 3) Return the variants.
+	return variants
+}
+
+// conformanceFieldVariants returns the variants of a single field.
+func conformanceFieldVariants(formType reflect.Type, validations *Validations, base any, field string) []conformanceVariant {
+
+	// 1) Initialize the variants list.
+	var variants []conformanceVariant
+	add := func(name string, value any) {
+		variants = append(variants, conformanceVariant{name: field + "_" + name, value: value})
+	}
+	isList := strings.HasPrefix(validations.Type, "[]")
+	element := strings.TrimPrefix(validations.Type, "[]")
+
+	// 2) Required and wrong type.
+	switch {
+	case element == "", element == "file":
+		return nil
+	case element == "string" && !isList:
+		add("wrong_type", map[string]any{})
+	default:
+		add("wrong_type", "wrong type")
+	}
+	if validations.Required {
+		variants = append(variants, conformanceVariant{name: field + "_missing", remove: true})
+	}
+
+	// 3) Min and max boundaries.
+	sized := func(size float64) any {
+		switch {
+		case isList:
+			elements := base.([]any)
+			list := make([]any, int(size))
+			for i := range list {
+				list[i] = elements[0]
+			}
+			return list
+		case element == "string":
+			return strings.Repeat("a", int(size))
+		default:
+			return size
+		}
+	}
+	if validations.Min != 0 {
+		add("min", sized(validations.Min))
+		if validations.Min-1 >= 0 || !isList && element != "string" {
+			add("below_min", sized(validations.Min-1))
+		}
+	}
+	if validations.Max != 0 {
+		add("max", sized(validations.Max))
+		add("above_max", sized(validations.Max+1))
+	}
+	if validations.Len != 0 {
+		add("below_len", sized(float64(validations.Len-1)))
+		add("above_len", sized(float64(validations.Len+1)))
+	}
+
+	// 4) Choices and excluded values.
+	for _, choice := range validations.Choices {
+		if isList {
+			add("choice_"+strings.ReplaceAll(jsonString(choice), "\"", ""), []any{choice})
+		} else {
+			add("choice_"+strings.ReplaceAll(jsonString(choice), "\"", ""), choice)
+		}
+	}
+	if validations.Choices != nil {
+		var invalid any = "invalid choice"
+		if element == "int" || element == "float" {
+			invalid = maxChoice(validations.Choices) + 1
+		}
+		if isList {
+			invalid = []any{invalid}
+		}
+		add("invalid_choice", invalid)
+	}
+	for _, excluded := range validations.Excluded {
+		if isList {
+			add("excluded_"+strings.ReplaceAll(jsonString(excluded), "\"", ""), []any{excluded})
+		} else {
+			add("excluded_"+strings.ReplaceAll(jsonString(excluded), "\"", ""), excluded)
+		}
+	}
+
+	// 5) Inner objects, the variants of a list of objects are applied on its first element.
+	if element == "struct" {
+		structField, _ := formType.FieldByName(validations.structField)
+		innerType := structType(structField.Type)
+		if isList {
+			for _, variant := range conformanceObjectVariants(innerType, base.([]any)[0].(map[string]any), field+"[0]") {
+				variants = append(variants, conformanceVariant{name: variant.name, value: []any{variant.value}})
+			}
+		} else {
+			variants = append(variants, conformanceObjectVariants(innerType, base.(map[string]any), field)...)
+		}
+	}
+
+	// 6) Return the variants.
+	return variants
+}
+
+func jsonString(value any) string {
+	jsonData, _ := json.Marshal(value)
+	return string(jsonData)
+}
+
+func maxChoice(choices []any) float64 {
+	var result float64
+	for i, choice := range choices {
+		var value float64
+		switch v := choice.(type) {
+		case int:
+			value = float64(v)
+		case float64:
+			value = v
+		default:
+			value, _ = strconv.ParseFloat(jsonString(v), 64)
+		}
+		if i == 0 || value > result {
+			result = value
+		}
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package jsonValidator
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestGenerateConformanceCases(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string;required=true;max=3"`
+	}
+	type createObject struct {
+		Code   *int     `validations:"type=int;min=1;max=10"`
+		Status *string  `validations:"type=string;choices=a,b"`
+		Tags   []string `validations:"type=[]string;max=1"`
+		Person *Person  `validations:"type=struct"`
+	}
+	want := []ConformanceCase{
+		{Name: "valid", Payload: json.RawMessage(`{"code":1,"person":{"name":""},"status":"a","tags":[""]}`), Errors: []ConformanceError{}},
+		{Name: "code_wrong_type", Payload: json.RawMessage(`{"code":"wrong type","person":{"name":""},"status":"a","tags":[""]}`), Errors: []ConformanceError{{Field: "code", Message: "This field has an invalid format (wrong type)."}}},
+		{Name: "code_min", Payload: json.RawMessage(`{"code":1,"person":{"name":""},"status":"a","tags":[""]}`), Errors: []ConformanceError{}},
+		{Name: "code_below_min", Payload: json.RawMessage(`{"code":0,"person":{"name":""},"status":"a","tags":[""]}`), Errors: []ConformanceError{{Field: "code", Message: "This field must be bigger than 1."}}},
+		{Name: "code_max", Payload: json.RawMessage(`{"code":10,"person":{"name":""},"status":"a","tags":[""]}`), Errors: []ConformanceError{}},
+		{Name: "code_above_max", Payload: json.RawMessage(`{"code":11,"person":{"name":""},"status":"a","tags":[""]}`), Errors: []ConformanceError{{Field: "code", Message: "This field must be smaller than 10."}}},
+		{Name: "person_wrong_type", Payload: json.RawMessage(`{"code":1,"person":"wrong type","status":"a","tags":[""]}`), Errors: []ConformanceError{{Field: "person", Message: "This field has an invalid format (wrong type)."}}},
+		{Name: "person.name_wrong_type", Payload: json.RawMessage(`{"code":1,"person":{"name":{}},"status":"a","tags":[""]}`), Errors: []ConformanceError{{Field: "person.name", Message: "This field has an invalid format (map[])."}}},
+		{Name: "person.name_missing", Payload: json.RawMessage(`{"code":1,"person":{},"status":"a","tags":[""]}`), Errors: []ConformanceError{{Field: "person.name", Message: "This field is required."}}},
+		{Name: "person.name_max", Payload: json.RawMessage(`{"code":1,"person":{"name":"aaa"},"status":"a","tags":[""]}`), Errors: []ConformanceError{}},
+		{Name: "person.name_above_max", Payload: json.RawMessage(`{"code":1,"person":{"name":"aaaa"},"status":"a","tags":[""]}`), Errors: []ConformanceError{{Field: "person.name", Message: "This field must not have more than 3 characters."}}},
+		{Name: "status_wrong_type", Payload: json.RawMessage(`{"code":1,"person":{"name":""},"status":{},"tags":[""]}`), Errors: []ConformanceError{{Field: "status", Message: "This field has an invalid format (map[])."}}},
+		{Name: "status_choice_a", Payload: json.RawMessage(`{"code":1,"person":{"name":""},"status":"a","tags":[""]}`), Errors: []ConformanceError{}},
+		{Name: "status_choice_b", Payload: json.RawMessage(`{"code":1,"person":{"name":""},"status":"b","tags":[""]}`), Errors: []ConformanceError{}},
+		{Name: "status_invalid_choice", Payload: json.RawMessage(`{"code":1,"person":{"name":""},"status":"invalid choice","tags":[""]}`), Errors: []ConformanceError{{Field: "status", Message: "This field has an invalid choice (invalid choice). The valid choices are ([a b])"}}},
+		{Name: "tags_wrong_type", Payload: json.RawMessage(`{"code":1,"person":{"name":""},"status":"a","tags":"wrong type"}`), Errors: []ConformanceError{{Field: "tags", Message: "This field has an invalid format (wrong type)."}}},
+		{Name: "tags_max", Payload: json.RawMessage(`{"code":1,"person":{"name":""},"status":"a","tags":[""]}`), Errors: []ConformanceError{}},
+		{Name: "tags_above_max", Payload: json.RawMessage(`{"code":1,"person":{"name":""},"status":"a","tags":["",""]}`), Errors: []ConformanceError{{Field: "tags", Message: "This field must not have more than 1 elements."}}},
+	}
+	got := GenerateConformanceCases(createObject{})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GenerateConformanceCases() = %s, want %s", got, want)
+	}
+	if _, err := json.Marshal(got); err != nil {
+		t.Errorf("json.Marshal() error = %v", err)
+	}
+}
@@ -0,0 +1,217 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RuleCoverage records the declared rules that rejected at least one payload, so a test suite can report the
+// rules that were never triggered. It is safe for concurrent use.
+type RuleCoverage struct {
+	mu        sync.Mutex
+	triggered map[string]bool
+}
+
+// NewRuleCoverage returns an empty RuleCoverage, to be passed to the validations through WithRuleCoverage.
+func NewRuleCoverage() *RuleCoverage {
+	return &RuleCoverage{triggered: make(map[string]bool)}
+}
+
+// WithRuleCoverage records in coverage every rule that produced an error during the validation.
+func WithRuleCoverage(coverage *RuleCoverage) Option {
+	return func(o *options) {
+		o.ruleCoverage = coverage
+	}
+}
+
+// listIndexes matches the list indexes of a field path.
+var listIndexes = regexp.MustCompile(`\[\d+\]`)
+
+func (c *RuleCoverage) record(fieldName, rule string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.triggered[fieldName+":"+rule] = true
+}
+
+// Untriggered returns the rules declared by the form that never produced an error, as "field:rule"
+// (e.g. "person.name:max"), sorted.
+func (c *RuleCoverage) Untriggered(form any) []string {
+
+	// 1) Get every declared rule of the form.
+	declared := declaredRules(structType(reflect.TypeOf(form)), "")
+
+	// 2) Keep the ones that were never triggered.
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var untriggered []string
+	for _, rule := range declared {
+		if !c.triggered[rule] {
+			untriggered = append(untriggered, rule)
+		}
+	}
+
+	// 3) Return the sorted rules.
+	sort.Strings(untriggered)
+	return untriggered
+}
+
+func declaredRules(formType reflect.Type, parent string, ancestors ...reflect.Type) []string {
+
+	// 1) Initialize the rules list, the interface fields have no declared fields.
+	var rules []string
+	if formType.Kind() != reflect.Struct {
+		return nil
+	}
+	ancestors = append(ancestors, formType)
+
+	// 2) Iterate over the validations of each field.
+	for fieldName, validations := range getValidations(reflect.New(formType).Elem(), defaultSyntax()) {
+		fieldName = getFieldName(parent, fieldName)
+
+		// 2.1) Add the rules declared on the field.
+		if validations.Type != "" && validations.Type != "file" {
+			rules = append(rules, fieldName+":type")
+		}
+		if validations.Required {
+			rules = append(rules, fieldName+":required")
+		}
+		if validations.RequiredIf != nil {
+			rules = append(rules, fieldName+":required_if")
+		}
+		if validations.RequiredUnless != nil {
+			rules = append(rules, fieldName+":required_unless")
+		}
+		if validations.RequiredWith != nil {
+			rules = append(rules, fieldName+":required_with")
+		}
+		if validations.DisallowEmptyList {
+			rules = append(rules, fieldName+":allowEmptyList")
+		}
+		if validations.Min != 0 {
+			rules = append(rules, fieldName+":min")
+		}
+		if validations.Max != 0 {
+			rules = append(rules, fieldName+":max")
+		}
+		if validations.Len != 0 {
+			rules = append(rules, fieldName+":len")
+		}
+		if validations.MinKeys != 0 {
+			rules = append(rules, fieldName+":minKeys")
+		}
+		if validations.MaxKeys != 0 {
+			rules = append(rules, fieldName+":maxKeys")
+		}
+		if validations.MinDigits != 0 {
+			rules = append(rules, fieldName+":minDigits")
+		}
+		if validations.MaxDigits != 0 {
+			rules = append(rules, fieldName+":maxDigits")
+		}
+		if validations.Pattern != nil {
+			rules = append(rules, fieldName+":pattern")
+		}
+		if validations.Precision != 0 {
+			rules = append(rules, fieldName+":precision")
+		}
+		if validations.Type == "money" && validations.Scale >= 0 {
+			rules = append(rules, fieldName+":scale")
+		}
+		if validations.Currencies != nil {
+			rules = append(rules, fieldName+":currencies")
+		}
+		if validations.MediaTypes != nil {
+			rules = append(rules, fieldName+":mediaTypes")
+		}
+		if validations.Checksum != "" {
+			rules = append(rules, fieldName+":checksum")
+		}
+		if validations.Custom != nil {
+			rules = append(rules, fieldName+":custom")
+		}
+		if validations.Normalize != "" {
+			rules = append(rules, fieldName+":normalize")
+		}
+		if validations.Format != "" && validations.Type != "datetime" {
+			rules = append(rules, fieldName+":format")
+		}
+		if validations.Prefixes != nil {
+			rules = append(rules, fieldName+":prefix")
+		}
+		if validations.Country != "" {
+			rules = append(rules, fieldName+":country")
+		}
+		if validations.InField != "" {
+			rules = append(rules, fieldName+":inField")
+		}
+		if validations.Fields != nil {
+			rules = append(rules, fieldName+":fields")
+		}
+		if validations.Operators != nil {
+			rules = append(rules, fieldName+":operators")
+		}
+		if validations.DisallowBidi {
+			rules = append(rules, fieldName+":allowBidi")
+		}
+		if validations.NoEmoji {
+			rules = append(rules, fieldName+":noEmoji")
+		}
+		if validations.NoMarkup {
+			rules = append(rules, fieldName+":noMarkup")
+		}
+		if validations.Choices != nil || validations.ChoicesFunc != "" {
+			rules = append(rules, fieldName+":choices")
+		}
+		if validations.Excluded != nil {
+			rules = append(rules, fieldName+":exclude")
+		}
+		if validations.Impl != nil {
+			rules = append(rules, fieldName+":impl")
+		}
+		if validations.ListRule != "" {
+			rules = append(rules, fieldName+":listRule")
+		}
+		if validations.Sorted != "" {
+			rules = append(rules, fieldName+":sorted")
+		}
+		if validations.IntervalStart != "" {
+			rules = append(rules, fieldName+":nonOverlapping")
+		}
+		if validations.SumMin != 0 {
+			rules = append(rules, fieldName+":sumMin")
+		}
+		if validations.SumMax != 0 {
+			rules = append(rules, fieldName+":sumMax")
+		}
+		if validations.AvgMin != 0 {
+			rules = append(rules, fieldName+":avgMin")
+		}
+		if validations.AvgMax != 0 {
+			rules = append(rules, fieldName+":avgMax")
+		}
+		if validations.MaxBytes != 0 {
+			rules = append(rules, fieldName+":maxBytes")
+		}
+
+		// 2.2) Add the rules of the inner forms, once for the recursive forms.
+		if strings.TrimPrefix(validations.Type, "[]") == "struct" {
+			field, _ := formType.FieldByName(validations.structField)
+			if innerType := structType(field.Type); !containsType(ancestors, innerType) {
+				rules = append(rules, declaredRules(innerType, fieldName, ancestors...)...)
+			}
+		}
+	}
+
+	// 3) Return the rules.
+	return rules
+}
+
+// trigger records that a rule of a field produced an error, when the rule coverage is enabled.
+func (s *state) trigger(fieldName, rule string) {
+	if s.options.ruleCoverage != nil {
+		s.options.ruleCoverage.record(s.rulePath(fieldName), rule)
+	}
+}
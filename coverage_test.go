@@ -0,0 +1,30 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRuleCoverage(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string;required=true;max=3"`
+	}
+	type createObject struct {
+		Code       *int     `validations:"type=int;min=1;max=10"`
+		Status     *string  `validations:"type=string;choices=a,b"`
+		PersonList []Person `validations:"type=[]struct;min=1"`
+	}
+	coverage := NewRuleCoverage()
+	payloads := []string{
+		`{"code": 0, "personList": [{"name": "long name"}]}`,
+		`{"code": "a", "status": "c", "personList": [{}]}`,
+		`{"code": 5, "personList": [{"name": "abc"}]}`,
+	}
+	for _, payload := range payloads {
+		Validate([]byte(payload), new(createObject), WithRuleCoverage(coverage))
+	}
+	want := []string{"code:max", "personList.name:type", "personList:min", "personList:type", "status:type"}
+	if got := coverage.Untriggered(createObject{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Untriggered() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,83 @@
+package jsonValidator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CustomValidation validates the parsed value of a field (a string, int, float64, bool, time.Time, GeoPoint or Money,
+// or an element of the lists) with the parameter of the rule. It returns an error to reject the value.
+type CustomValidation func(value any, param string) error
+
+// CustomValidations holds the custom validations available to the "custom=" validation, indexed by name.
+var CustomValidations = map[string]CustomValidation{}
+
+// RegisterValidation registers a custom validation under the given name so it can be used as "custom=name" (or
+// "custom=name:param" to pass a parameter). The rejected values are reported with the message registered under the
+// name of the validation (see RegisterMessage), formatted with the parameter, or with the returned error otherwise.
+func RegisterValidation(name string, fn func(value any, param string) error) {
+	CustomValidations[name] = fn
+}
+
+// validateCustom runs the custom validations of the field against its parsed value.
+func (s *state) validateCustom(validations *Validations, fieldName string, value any) []error {
+
+	// 1) Initialize the errors list, a validation that is not registered is a ConfigError.
+	var errors []error
+	if err := s.unknownCustom(validations); err != nil {
+		return []error{err}
+	}
+
+	// 2) Run each validation.
+	for _, custom := range validations.Custom {
+		name, param, _ := strings.Cut(custom, s.options.syntax().choiceLabelSeparator)
+		err := CustomValidations[name](value, param)
+		if err == nil {
+			continue
+		}
+
+		// 2.1) Report the message of the validation, or the returned error.
+		s.trigger(fieldName, "custom")
+		message := err.Error()
+		if s.options.message(name) != "" {
+			message = s.options.format(name, fieldName, param)
+		}
+		errors = append(errors, ValidationError{
+			Field:   fieldName,
+			Message: message,
+			Code:    name,
+		})
+	}
+
+	// 3) Return the errors.
+	return errors
+}
+
+func validateListCustom[T string | int | int64 | uint64 | float64](s *state, validations *Validations, parsedValues []T, parent string) []error {
+
+	// 1) Initialize an errors list, a validation that is not registered is reported once.
+	var errors []error
+	if err := s.unknownCustom(validations); err != nil {
+		return []error{err}
+	}
+
+	// 2) Run the custom validations against each element.
+	for i, element := range parsedValues {
+		errors = append(errors, s.validateCustom(validations, parent+"["+strconv.Itoa(i)+"]", element)...)
+	}
+
+	// 3) Return the errors.
+	return errors
+}
+
+// unknownCustom returns a ConfigError for the first custom validation of the field that is not registered.
+func (s *state) unknownCustom(validations *Validations) error {
+	for _, custom := range validations.Custom {
+		name, _, _ := strings.Cut(custom, s.options.syntax().choiceLabelSeparator)
+		if _, ok := CustomValidations[name]; !ok {
+			return ConfigError{Message: fmt.Sprintf("no custom validation is registered as %s", name)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package jsonValidator
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestValidate_Custom(t *testing.T) {
+	slug := regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	RegisterValidation("slug", func(value any, param string) error {
+		if !slug.MatchString(value.(string)) {
+			return errors.New("This field must be a slug.")
+		}
+		return nil
+	})
+	RegisterValidation("multipleOf", func(value any, param string) error {
+		multiple, _ := strconv.Atoi(param)
+		if value.(int)%multiple != 0 {
+			return errors.New("not a multiple")
+		}
+		return nil
+	})
+	RegisterMessage("multipleOf", "This field must be a multiple of {param}.")
+	defer func() {
+		delete(CustomValidations, "slug")
+		delete(CustomValidations, "multipleOf")
+		delete(DefaultMessages, "multipleOf")
+	}()
+	type createObject struct {
+		Slug     *string  `validations:"type=string;custom=slug"`
+		Quantity *int     `validations:"type=int;custom=multipleOf:6"`
+		Tags     []string `validations:"type=[]string;custom=slug"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_custom",
+			jsonData: []byte(`{"slug": "my-object", "quantity": 12, "tags": ["go", "json-validator"]}`),
+			want:     nil,
+		},
+		{
+			name:     "test_custom_invalid",
+			jsonData: []byte(`{"slug": "My Object", "quantity": 8, "tags": ["go", "Json"]}`),
+			want: []error{
+				ValidationError{Field: "quantity", Message: "This field must be a multiple of 6.", Code: "multipleOf"},
+				ValidationError{Field: "slug", Message: "This field must be a slug.", Code: "slug"},
+				ValidationError{Field: "tags[1]", Message: "This field must be a slug.", Code: "slug"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_Unregistered(t *testing.T) {
+	type Item struct {
+		Name *string `validations:"type=string"`
+	}
+	type customObject struct {
+		Slug *string  `validations:"type=string;custom=unknown"`
+		Tags []string `validations:"type=[]string;custom=unknown:1"`
+	}
+	type normalizeObject struct {
+		Code  *string  `validations:"type=string;normalize=unknown"`
+		Codes []string `validations:"type=[]string;normalize=unknown"`
+	}
+	type listRuleObject struct {
+		Items []Item `validations:"type=[]struct;listRule=unknown"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		form     any
+		want     []error
+	}{
+		{
+			name:     "test_unregistered_custom",
+			jsonData: []byte(`{"slug": "go", "tags": ["go", "json"]}`),
+			form:     new(customObject),
+			want: []error{
+				ConfigError{Message: "no custom validation is registered as unknown"},
+				ConfigError{Message: "no custom validation is registered as unknown"},
+			},
+		},
+		{
+			name:     "test_unregistered_normalize",
+			jsonData: []byte(`{"code": "go", "codes": ["go", "json"]}`),
+			form:     new(normalizeObject),
+			want: []error{
+				ConfigError{Message: "no normalizer is registered as unknown"},
+				ConfigError{Message: "no normalizer is registered as unknown"},
+			},
+		},
+		{
+			name:     "test_unregistered_list_rule",
+			jsonData: []byte(`{"items": [{"name": "go"}]}`),
+			form:     new(listRuleObject),
+			want:     []error{ConfigError{Message: "no list rule is registered as unknown"}},
+		},
+		{
+			name:     "test_unregistered_absent",
+			jsonData: []byte(`{}`),
+			form:     new(customObject),
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, tt.form)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			if len(got) > 0 && ValidationErrors(got).Class() != ClassConfiguration {
+				t.Errorf("Class() = %v, want %v", ValidationErrors(got).Class(), ClassConfiguration)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package jsonValidator
+
+import (
+	"encoding/base64"
+	"mime"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// DataURI is the value bound into the "format=datauri" fields declared as *DataURI, from a
+// "data:image/png;base64,..." string.
+type DataURI struct {
+	MediaType string
+	Data      []byte
+}
+
+// String returns the base64 data URI of the value.
+func (d DataURI) String() string {
+	return "data:" + d.MediaType + ";base64," + base64.StdEncoding.EncodeToString(d.Data)
+}
+
+// validateDataURI validates a data URI ("data:image/png;base64,iVBOR...") against the allowed media types and the max
+// bytes of its decoded data, and binds it into the field: the string itself, or the decoded DataURI for the *DataURI
+// fields.
+func (s *state) validateDataURI(validations *Validations, fieldName string, value string, form reflect.Value) []error {
+
+	// 1) Parse the media type and the data.
+	header, data, found := strings.Cut(strings.TrimPrefix(value, "data:"), ",")
+	if !found || !strings.HasPrefix(value, "data:") {
+		return []error{s.dataURIError(fieldName)}
+	}
+	header, isBase64 := strings.CutSuffix(header, ";base64")
+	mediaType := "text/plain"
+	if header != "" && !strings.HasPrefix(header, ";") {
+		parsed, _, err := mime.ParseMediaType(header)
+		if err != nil {
+			return []error{s.dataURIError(fieldName)}
+		}
+		mediaType = parsed
+	}
+
+	// 2) Validate the media type.
+	if validations.MediaTypes != nil && !containsAny(toAny(validations.MediaTypes), mediaType) {
+		s.trigger(fieldName, "mediaTypes")
+		return []error{ValidationError{
+			Field:   fieldName,
+			Message: s.options.format("InvalidMediaType", fieldName, mediaType, validations.MediaTypes),
+			Code:    "media_type",
+		}}
+	}
+
+	// 3) Reject the data too large from its encoded size, before decoding it.
+	if validations.MaxBytes > 0 && isBase64 && int64(base64.StdEncoding.DecodedLen(len(data))) > validations.MaxBytes+2 {
+		return []error{s.dataURISizeError(validations, fieldName)}
+	}
+
+	// 4) Decode the data, base64 or percent-encoded.
+	var decoded []byte
+	if isBase64 {
+		var err error
+		if decoded, err = base64.StdEncoding.DecodeString(data); err != nil {
+			return []error{s.dataURIError(fieldName)}
+		}
+	} else {
+		unescaped, err := url.PathUnescape(data)
+		if err != nil {
+			return []error{s.dataURIError(fieldName)}
+		}
+		decoded = []byte(unescaped)
+	}
+	if validations.MaxBytes > 0 && int64(len(decoded)) > validations.MaxBytes {
+		return []error{s.dataURISizeError(validations, fieldName)}
+	}
+
+	// 5) Update form with the data URI, decoded for the *DataURI fields.
+	if field := formField(form, validations); field.Type() == reflect.TypeOf(&DataURI{}) {
+		field.Set(reflect.ValueOf(&DataURI{MediaType: mediaType, Data: decoded}))
+		return nil
+	}
+	setValue(form, validations, &value)
+
+	// 6) Return errors.
+	return nil
+}
+
+func (s *state) dataURIError(fieldName string) ValidationError {
+	s.trigger(fieldName, "format")
+	return ValidationError{
+		Field:   fieldName,
+		Message: s.options.format("InvalidDataUri", fieldName),
+		Code:    "format",
+	}
+}
+
+func (s *state) dataURISizeError(validations *Validations, fieldName string) ValidationError {
+	s.trigger(fieldName, "maxBytes")
+	return ValidationError{
+		Field:   fieldName,
+		Message: s.options.format("InvalidFileSize", fieldName, validations.MaxBytes),
+		Code:    "max_bytes",
+	}
+}
@@ -0,0 +1,81 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_DataURI(t *testing.T) {
+	type createObject struct {
+		Avatar *DataURI `validations:"type=string;format=datauri;mediaTypes=image/png,image/jpeg;maxBytes=4"`
+		Note   *string  `validations:"type=string;format=datauri"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     *createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_valid",
+			jsonData: []byte("{\"avatar\": \"data:image/PNG;base64,AQIDBA==\", \"note\": \"data:,hello%20world\"}"),
+			want: &createObject{
+				Avatar: &DataURI{MediaType: "image/png", Data: []byte{1, 2, 3, 4}},
+				Note:   toStringPointer("data:,hello%20world"),
+			},
+		},
+		{
+			name:     "test_invalid_format",
+			jsonData: []byte("{\"avatar\": \"image/png;base64,AQID\", \"note\": \"data:text/plain;base64,***\"}"),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "avatar", Message: defaultMessage("InvalidDataUri"), Code: "format"},
+				ValidationError{Field: "note", Message: defaultMessage("InvalidDataUri"), Code: "format"},
+			},
+		},
+		{
+			name:     "test_invalid_media_type",
+			jsonData: []byte("{\"avatar\": \"data:image/gif;base64,AQID\"}"),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "avatar", Message: defaultMessage("InvalidMediaType", "image/gif", []string{"image/png", "image/jpeg"}), Code: "media_type"},
+			},
+		},
+		{
+			name:     "test_too_large",
+			jsonData: []byte("{\"avatar\": \"data:image/png;base64,AQIDBAU=\"}"),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "avatar", Message: defaultMessage("InvalidFileSize", 4), Code: "max_bytes"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(createObject)
+			gotErr := Validate(tt.jsonData, got)
+
+			// Sort
+			sort.Sort(Errors(gotErr))
+			sort.Sort(Errors(tt.wantErr))
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", gotErr, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() form = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshal_DataURI(t *testing.T) {
+	type createObject struct {
+		Avatar *DataURI `validations:"type=string;format=datauri"`
+	}
+	got, err := Marshal(createObject{Avatar: &DataURI{MediaType: "image/png", Data: []byte{1, 2, 3, 4}}})
+	if want := "{\"avatar\":\"data:image/png;base64,AQIDBA==\"}"; err != nil || string(got) != want {
+		t.Errorf("Marshal() = %s, %v, want %s", got, err, want)
+	}
+}
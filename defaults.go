@@ -0,0 +1,238 @@
+package jsonValidator
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// parseDefault parses a "default=" rule: a literal value ("default=draft") or a value derived from another field of
+// the same object, optionally rewritten by a normalizer ("default=field:name|transform:slug"). The transform is chained
+// with "|", so the derived defaults are a ConfigError when "|" is also a separator of the tags (see WithSeparators).
+func parseDefault(validations *Validations, value string, syntax tagSyntax) {
+	for i, part := range strings.Split(value, defaultChainSeparator) {
+		name, argument, found := strings.Cut(part, syntax.choiceLabelSeparator)
+		switch {
+		case i == 0 && found && name == "field" && argument != "":
+			if syntax.separator == defaultChainSeparator || syntax.choiceLabelSeparator == defaultChainSeparator {
+				validations.invalidDefault = value
+				return
+			}
+			validations.DefaultField = argument
+		case i == 0:
+			validations.Default = value
+			return
+		case found && name == "transform" && argument != "":
+			validations.DefaultTransform = argument
+		}
+	}
+}
+
+// defaultChainSeparator chains the transform of a derived default to its field.
+const defaultChainSeparator = "|"
+
+// applyDefault binds the default value of a field that was not received, and reports whether it was bound. A derived
+// default is only bound when its field was received as a string or a number that the transform accepts, and the
+// values that do not fit the type of the field are not bound. The default is validated against the min, max, pattern
+// and choices rules of the field: a literal default that fails them is a ConfigError, and a derived one returns the
+// errors of the field.
+func (s *state) applyDefault(objectNode int, form reflect.Value, validations *Validations, fieldName string) (bool, []error) {
+
+	// 1) Get the literal value, or the value of the other field rewritten by the transform.
+	if validations.invalidDefault != "" {
+		return false, []error{ConfigError{Message: fmt.Sprintf("the default value %s of the field %s cannot be parsed, %s being a separator of the tags", validations.invalidDefault, fieldName, defaultChainSeparator)}}
+	}
+	value := validations.Default
+	if validations.DefaultField != "" {
+		node := s.document.member(objectNode, validations.DefaultField)
+		if node < 0 || s.document.kind(node) != kindString && s.document.kind(node) != kindNumber {
+			return false, nil
+		}
+		value = fmt.Sprintf("%v", s.document.value(node))
+		if normalizer, ok := Normalizers[validations.DefaultTransform]; ok {
+			if value, ok = normalizer(value); !ok {
+				return false, nil
+			}
+		}
+	} else if value == "" {
+		return false, nil
+	}
+
+	// 2) Parse the value as the type of the field.
+	var parsed any
+	var err error
+	switch validations.Type {
+	case "string":
+		parsed = value
+	case "int":
+		parsed, err = strconv.Atoi(value)
+	case "float":
+		parsed, err = strconv.ParseFloat(value, 64)
+	case "bool":
+		parsed, err = strconv.ParseBool(value)
+	default:
+		return false, nil
+	}
+	if err != nil {
+		return false, nil
+	}
+	if parsed, ok := parsed.(int); ok && validations.intType != nil {
+		if minimum, maximum := intBounds(validations.intType); big.NewInt(int64(parsed)).Cmp(minimum) < 0 || big.NewInt(int64(parsed)).Cmp(maximum) > 0 {
+			if validations.DefaultField == "" {
+				return false, []error{ConfigError{Message: fmt.Sprintf("the default value %s of the field %s does not fit its type %s", value, fieldName, validations.intType)}}
+			}
+			return false, nil
+		}
+	}
+
+	// 3) Validate the value against the rules of the field, the rules that are not valid are reported as they are.
+	if err := patternError(validations); err != nil {
+		return false, []error{err}
+	}
+	if errors := s.defaultErrors(validations, fieldName, parsed); errors != nil {
+		for _, err := range errors {
+			if Classify(err) != ClassClient {
+				return false, []error{err}
+			}
+		}
+		if validations.DefaultField == "" {
+			return false, []error{ConfigError{Message: fmt.Sprintf("the default value %s of the field %s does not pass its rules", value, fieldName)}}
+		}
+		return false, errors
+	}
+
+	// 4) Bind the value.
+	switch parsed := parsed.(type) {
+	case string:
+		setValue(form, validations, &parsed)
+	case int:
+		if validations.intType != nil {
+			setField(formField(form, validations), reflect.ValueOf(parsed).Convert(validations.intType))
+		} else {
+			setValue(form, validations, &parsed)
+		}
+	case float64:
+		setValue(form, validations, &parsed)
+	case bool:
+		setValue(form, validations, &parsed)
+	}
+	return true, nil
+}
+
+// defaultErrors validates a default value against the min, max, pattern and choices rules of its field, the length of
+// the strings and the value of the numbers being checked against min and max.
+func (s *state) defaultErrors(validations *Validations, fieldName string, value any) []error {
+
+	// 1) Initialize the errors list and the size of the value.
+	var errors []error
+	var size float64
+	minMessage, maxMessage := "InvalidMinNumber", "InvalidMaxNumber"
+	switch value := value.(type) {
+	case string:
+		size, minMessage, maxMessage = float64(len(value)), "InvalidMinString", "InvalidMaxString"
+	case int:
+		size = float64(value)
+	case float64:
+		size = value
+	case bool:
+		minMessage, maxMessage = "", ""
+	}
+
+	// 2) Validate min and max.
+	if minMessage != "" && validations.Min != 0 && size < validations.Min {
+		s.trigger(fieldName, "min")
+		errors = append(errors, ValidationError{
+			Field:   fieldName,
+			Message: s.options.format(minMessage, fieldName, validations.Min),
+			Code:    "min",
+		})
+	}
+	if maxMessage != "" && validations.Max != 0 && size > validations.Max {
+		s.trigger(fieldName, "max")
+		errors = append(errors, ValidationError{
+			Field:   fieldName,
+			Message: s.options.format(maxMessage, fieldName, validations.Max),
+			Code:    "max",
+		})
+	}
+
+	// 3) Validate the pattern.
+	if text, ok := value.(string); ok && validations.Pattern != nil && !validations.Pattern.MatchString(text) {
+		s.trigger(fieldName, "pattern")
+		errors = append(errors, ValidationError{
+			Field:   fieldName,
+			Message: s.options.format("InvalidPattern", fieldName, validations.Pattern),
+			Code:    "pattern",
+		})
+	}
+
+	// 4) Validate choices, the ones of a provider resolved now.
+	validations, err := resolveChoices(validations)
+	if err != nil {
+		return append(errors, err)
+	}
+	if validations.Choices != nil && !containsDefault(validations, value) {
+		s.trigger(fieldName, "choices")
+		errors = append(errors, ValidationError{
+			Field:   fieldName,
+			Message: s.choiceMessage(fieldName, value, validations),
+			Code:    "choice",
+		})
+	}
+
+	// 5) Return the errors.
+	return errors
+}
+
+// containsDefault reports whether a default value is one of the choices of the validations.
+func containsDefault(validations *Validations, value any) bool {
+	switch value := value.(type) {
+	case string:
+		return contains[string](validations.Choices, value)
+	case int:
+		return contains[int](validations.Choices, value)
+	case float64:
+		return containsChoice[float64](validations, validations.Choices, value)
+	}
+	return true
+}
+
+// applyZero binds a pointer to the zero value to an absent optional field, when it is a nil pointer to the type of its
+// validations (e.g. a *string for type=string). The double pointer fields are left nil, since a pointer to nil means
+// null.
+func applyZero(form reflect.Value, validations *Validations) {
+	if pointerTypes[validations.Type] == nil || validations.valueField || validations.nullField {
+		return
+	}
+	if field := formField(form, validations); field.Kind() == reflect.Pointer && field.IsNil() {
+		field.Set(reflect.New(field.Type().Elem()))
+	}
+}
+
+// NormalizeSlug normalizes a text into a URL slug ("Crème Brûlée!" is "creme-brulee"): the accents are removed, the
+// letters lowercased and every run of other characters than ASCII letters and digits replaced by a dash. The texts
+// without any letter or digit cannot be normalized.
+func NormalizeSlug(value string) (string, bool) {
+	var slug strings.Builder
+	dash := false
+	for _, r := range norm.NFD.String(value) {
+		r = unicode.ToLower(r)
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case 'a' <= r && r <= 'z' || '0' <= r && r <= '9':
+			if dash && slug.Len() > 0 {
+				slug.WriteByte('-')
+			}
+			slug.WriteRune(r)
+			dash = false
+		default:
+			dash = true
+		}
+	}
+	return slug.String(), slug.Len() > 0
+}
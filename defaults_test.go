@@ -0,0 +1,231 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidate_Default(t *testing.T) {
+	type createObject struct {
+		Name     *string  `validations:"type=string"`
+		Slug     *string  `validations:"type=string;required=true;default=field:name|transform:slug"`
+		Status   *string  `validations:"type=string;default=draft"`
+		Priority *int     `validations:"type=int;default=3"`
+		Ratio    *float64 `validations:"type=float;default=0.5"`
+		Public   *bool    `validations:"type=bool;default=true"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_default",
+			jsonData: []byte(`{"name": "Crème Brûlée!"}`),
+			want: createObject{Name: toStringPointer("Crème Brûlée!"), Slug: toStringPointer("creme-brulee"), Status: toStringPointer("draft"),
+				Priority: toIntPointer(3), Ratio: toFloatPointer(0.5), Public: toBoolPointer(true)},
+		},
+		{
+			name:     "test_default_received",
+			jsonData: []byte(`{"name": "Crème Brûlée", "slug": "dessert", "status": "published", "priority": 1, "ratio": 1, "public": false}`),
+			want: createObject{Name: toStringPointer("Crème Brûlée"), Slug: toStringPointer("dessert"), Status: toStringPointer("published"),
+				Priority: toIntPointer(1), Ratio: toFloatPointer(1), Public: toBoolPointer(false)},
+		},
+		{
+			name:     "test_default_without_field",
+			jsonData: []byte(`{"name": "!!"}`),
+			want: createObject{Name: toStringPointer("!!"), Status: toStringPointer("draft"), Priority: toIntPointer(3),
+				Ratio: toFloatPointer(0.5), Public: toBoolPointer(true)},
+			wantErr: []error{ValidationError{Field: "slug", Message: DefaultMessages["RequiredField"], Code: "required"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			if got := Validate(tt.jsonData, form); !reflect.DeepEqual(got, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", got, tt.wantErr)
+			}
+			if !reflect.DeepEqual(*form, tt.want) {
+				t.Errorf("Validate() form = %+v, want %+v", *form, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_DefaultRules(t *testing.T) {
+	type derivedObject struct {
+		Name *string `validations:"type=string"`
+		Slug *string `validations:"type=string;max=5;pattern=^[a-z-]+$;default=field:name|transform:slug"`
+	}
+	type choicesObject struct {
+		Status *string `validations:"type=string;choices=a,b;default=zzz"`
+	}
+	type maxObject struct {
+		Priority *int `validations:"type=int;max=5;default=9"`
+	}
+	type sizedObject struct {
+		Code  *string `validations:"type=string"`
+		Level *uint8  `validations:"type=int;default=field:code"`
+		Rank  *int8   `validations:"type=int;default=300"`
+	}
+	type separatorObject struct {
+		Name *string `validations:"type=string"`
+		Slug *string `validations:"type=string|default=field:name"`
+	}
+	level, rank := uint8(200), int8(1)
+	tests := []struct {
+		name     string
+		jsonData []byte
+		opts     []Option
+		form     any
+		want     any
+		wantErr  []error
+	}{
+		{
+			name:     "test_default_derived_valid",
+			jsonData: []byte(`{"name": "Tea"}`),
+			form:     new(derivedObject),
+			want:     &derivedObject{Name: toStringPointer("Tea"), Slug: toStringPointer("tea")},
+		},
+		{
+			name:     "test_default_derived_max",
+			jsonData: []byte(`{"name": "Crème Brûlée"}`),
+			form:     new(derivedObject),
+			want:     &derivedObject{Name: toStringPointer("Crème Brûlée")},
+			wantErr:  []error{ValidationError{Field: "slug", Message: defaultMessage("InvalidMaxString", 5), Code: "max"}},
+		},
+		{
+			name:     "test_default_derived_pattern",
+			jsonData: []byte(`{"name": "Tea 2"}`),
+			form:     new(derivedObject),
+			want:     &derivedObject{Name: toStringPointer("Tea 2")},
+			wantErr: []error{ValidationError{Field: "slug", Message: defaultMessage("InvalidPattern", "^[a-z-]+$"),
+				Code: "pattern"}},
+		},
+		{
+			name:     "test_default_literal_choices",
+			jsonData: []byte(`{}`),
+			form:     new(choicesObject),
+			want:     &choicesObject{},
+			wantErr:  []error{ConfigError{Message: "the default value zzz of the field status does not pass its rules"}},
+		},
+		{
+			name:     "test_default_literal_max",
+			jsonData: []byte(`{}`),
+			form:     new(maxObject),
+			want:     &maxObject{},
+			wantErr:  []error{ConfigError{Message: "the default value 9 of the field priority does not pass its rules"}},
+		},
+		{
+			name:     "test_default_sized_int",
+			jsonData: []byte(`{"code": "200", "rank": 1}`),
+			form:     new(sizedObject),
+			want:     &sizedObject{Code: toStringPointer("200"), Level: &level, Rank: &rank},
+		},
+		{
+			name:     "test_default_sized_int_overflow",
+			jsonData: []byte(`{"code": "300"}`),
+			form:     new(sizedObject),
+			want:     &sizedObject{Code: toStringPointer("300")},
+			wantErr:  []error{ConfigError{Message: "the default value 300 of the field rank does not fit its type int8"}},
+		},
+		{
+			name:     "test_default_separator_conflict",
+			jsonData: []byte(`{"name": "Tea"}`),
+			opts:     []Option{WithSeparators("|", "", "")},
+			form:     new(separatorObject),
+			want:     &separatorObject{Name: toStringPointer("Tea")},
+			wantErr:  []error{ConfigError{Message: "the default value field:name of the field slug cannot be parsed, | being a separator of the tags"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Validate(tt.jsonData, tt.form, tt.opts...); !reflect.DeepEqual(got, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", got, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.form, tt.want) {
+				t.Errorf("Validate() form = %+v, want %+v", tt.form, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_ZeroIfAbsent(t *testing.T) {
+	type createObject struct {
+		Name     *string  `validations:"type=string;required=true"`
+		Nickname *string  `validations:"type=string;zeroIfAbsent=true"`
+		Status   *string  `validations:"type=string;default=draft"`
+		Priority *int     `validations:"type=int"`
+		Size     *int32   `validations:"type=int"`
+		Ratio    *float64 `validations:"type=float"`
+		Public   *bool    `validations:"type=bool"`
+		Tags     []string `validations:"type=[]string"`
+		Parent   **string `validations:"type=string;nullable=true"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		opts     []Option
+		want     createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_zero_if_absent_field",
+			jsonData: []byte(`{"name": "Daniel"}`),
+			want:     createObject{Name: toStringPointer("Daniel"), Nickname: toStringPointer(""), Status: toStringPointer("draft")},
+		},
+		{
+			name:     "test_zero_if_absent_option",
+			jsonData: []byte(`{"name": "Daniel", "priority": 2}`),
+			opts:     []Option{WithZeroIfAbsent()},
+			want: createObject{Name: toStringPointer("Daniel"), Nickname: toStringPointer(""), Status: toStringPointer("draft"),
+				Priority: toIntPointer(2), Size: new(int32), Ratio: toFloatPointer(0), Public: toBoolPointer(false)},
+		},
+		{
+			name:     "test_zero_if_absent_required",
+			jsonData: []byte(`{}`),
+			opts:     []Option{WithZeroIfAbsent()},
+			want: createObject{Nickname: toStringPointer(""), Status: toStringPointer("draft"), Priority: toIntPointer(0),
+				Size: new(int32), Ratio: toFloatPointer(0), Public: toBoolPointer(false)},
+			wantErr: []error{ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"}},
+		},
+		{
+			name:     "test_zero_if_absent_partial",
+			jsonData: []byte(`{}`),
+			opts:     []Option{WithZeroIfAbsent(), WithPartial()},
+			want:     createObject{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			if got := Validate(tt.jsonData, form, tt.opts...); !reflect.DeepEqual(got, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", got, tt.wantErr)
+			}
+			if !reflect.DeepEqual(*form, tt.want) {
+				t.Errorf("Validate() form = %+v, want %+v", *form, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeSlug(t *testing.T) {
+	tests := []struct {
+		input  string
+		want   string
+		wantOk bool
+	}{
+		{input: "Crème Brûlée!", want: "creme-brulee", wantOk: true},
+		{input: "  Hello,   World 2024 ", want: "hello-world-2024", wantOk: true},
+		{input: "--", want: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := NormalizeSlug(tt.input)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("NormalizeSlug() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
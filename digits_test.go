@@ -0,0 +1,69 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_Digits(t *testing.T) {
+	type createObject struct {
+		Account *string  `validations:"type=string;format=digits;minDigits=8;maxDigits=10"`
+		Phone   *string  `validations:"type=string;minDigits=9"`
+		Codes   []string `validations:"type=[]string;format=digits;maxDigits=4"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+		account  string
+	}{
+		{
+			name:     "test_digits",
+			jsonData: []byte(`{"account": "00123456", "phone": "+34 600 000 000", "codes": ["0001", "12"]}`),
+			want:     nil,
+			account:  "00123456",
+		},
+		{
+			name:     "test_digits_invalid",
+			jsonData: []byte(`{"account": "1234-5678", "codes": ["12a"]}`),
+			want: []error{
+				ValidationError{Field: "account", Message: DefaultMessages["InvalidDigits"], Code: "format"},
+				ValidationError{Field: "codes[0]", Message: DefaultMessages["InvalidDigits"], Code: "format"},
+			},
+		},
+		{
+			name:     "test_digits_bounds",
+			jsonData: []byte(`{"account": "0012345", "phone": "600 000", "codes": ["0001", "12345"]}`),
+			want: []error{
+				ValidationError{Field: "account", Message: defaultMessage("InvalidMinDigits", 8), Code: "min_digits"},
+				ValidationError{Field: "phone", Message: defaultMessage("InvalidMinDigits", 9), Code: "min_digits"},
+				ValidationError{Field: "codes[1]", Message: defaultMessage("InvalidMaxDigits", 4), Code: "max_digits"},
+			},
+		},
+		{
+			name:     "test_digits_max",
+			jsonData: []byte(`{"account": "00123456789"}`),
+			want: []error{
+				ValidationError{Field: "account", Message: defaultMessage("InvalidMaxDigits", 10), Code: "max_digits"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := Validate(tt.jsonData, form)
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			if tt.account != "" && (form.Account == nil || *form.Account != tt.account) {
+				t.Errorf("Validate() account = %v, want %v", form.Account, tt.account)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"strings"
+)
+
+// FieldDoc is the documentation of a field of a form: its path, its type, the rules of its tag (without the type,
+// the description and the example), and the description and the example declared with "desc=" and "example=".
+type FieldDoc struct {
+	Field       string   `json:"field"`
+	Type        string   `json:"type"`
+	Rules       []string `json:"rules,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Example     string   `json:"example,omitempty"`
+}
+
+// Docs returns the documentation of the fields of a form (a struct or a pointer to a struct), including the fields of
+// its inner structs ("person.name"), in the order they are declared. The rules are read from the same tags as the
+// validations, so the rendered API reference cannot drift from what is enforced. The fields without type are skipped.
+func Docs(form any) []FieldDoc {
+	return formDocs(structType(reflect.TypeOf(form)), "")
+}
+
+func formDocs(formType reflect.Type, parent string, ancestors ...reflect.Type) []FieldDoc {
+
+	// 1) Get the validations of the form, the interface fields have no declared fields.
+	if formType.Kind() != reflect.Struct {
+		return nil
+	}
+	ancestors = append(ancestors, formType)
+	syntax := defaultSyntax()
+	validationsMap := getValidations(reflect.New(formType).Elem(), syntax)
+	var docs []FieldDoc
+
+	// 2) Iterate over the fields in the order of the form.
+	for _, field := range formFields(formType, syntax) {
+		validations := validationsMap[LowerCase(field.Name)]
+		if validations == nil || validations.Type == "" {
+			continue
+		}
+		fieldName := getFieldName(parent, LowerCase(field.Name))
+
+		// 2.1) Add the field, with the rules of its tag.
+		doc := FieldDoc{
+			Field:       fieldName,
+			Type:        validations.Type,
+			Description: validations.Description,
+			Example:     validations.Example,
+		}
+		for _, rule := range strings.Split(field.Tag.Get(syntax.name), syntax.separator) {
+			switch name, _, _ := strings.Cut(rule, "="); name {
+			case "", "type", "desc", "example":
+			default:
+				doc.Rules = append(doc.Rules, rule)
+			}
+		}
+		docs = append(docs, doc)
+
+		// 2.2) Add the fields of the inner forms, once for the recursive forms.
+		if strings.TrimPrefix(validations.Type, "[]") == "struct" {
+			if innerType := structType(field.Type); !containsType(ancestors, innerType) {
+				docs = append(docs, formDocs(innerType, fieldName, ancestors...)...)
+			}
+		}
+	}
+
+	// 3) Return the documentation.
+	return docs
+}
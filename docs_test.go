@@ -0,0 +1,90 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestDocs(t *testing.T) {
+	type person struct {
+		Name *string `validations:"type=string;required=true;max=50;desc=Full name of the owner;example=Daniel Silva"`
+	}
+	type recursive struct {
+		Name     *string     `validations:"type=string;desc=Name of the node"`
+		Children []recursive `validations:"type=[]struct;max=10"`
+	}
+	type createObject struct {
+		Code     *int    `validations:"type=int;required=true;min=1;max=999;desc=Code of the object;example=123"`
+		Status   *string `validations:"type=string;choices=active,inactive"`
+		Person   *person `validations:"type=struct;required=true;desc=Owner of the object"`
+		Internal *string
+	}
+	tests := []struct {
+		name string
+		form any
+		want []FieldDoc
+	}{
+		{
+			name: "test_docs",
+			form: new(createObject),
+			want: []FieldDoc{
+				{Field: "code", Type: "int", Rules: []string{"required=true", "min=1", "max=999"}, Description: "Code of the object", Example: "123"},
+				{Field: "status", Type: "string", Rules: []string{"choices=active,inactive"}},
+				{Field: "person", Type: "struct", Rules: []string{"required=true"}, Description: "Owner of the object"},
+				{Field: "person.name", Type: "string", Rules: []string{"required=true", "max=50"}, Description: "Full name of the owner", Example: "Daniel Silva"},
+			},
+		},
+		{
+			name: "test_docs_recursive",
+			form: recursive{},
+			want: []FieldDoc{
+				{Field: "name", Type: "string", Description: "Name of the node"},
+				{Field: "children", Type: "[]struct", Rules: []string{"max=10"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Docs(tt.form); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Docs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDocs_Validation(t *testing.T) {
+	type createObject struct {
+		Code *int              `validations:"type=int;desc=Code, between 1 and 999;example=1000;min=1;max=999"`
+		Tags map[string]string `validations:"type=map[string]string;max=5;desc=Labels of the object;example={\"env\":\"production\"}"`
+	}
+	tests := []struct {
+		name     string
+		jsonData string
+		want     []error
+	}{
+		{
+			name:     "test_desc_example_ignored",
+			jsonData: `{"code": 999, "tags": {"env": "prod"}}`,
+			want:     nil,
+		},
+		{
+			name:     "test_desc_example_rules",
+			jsonData: `{"code": 1000, "tags": {"env": "production"}}`,
+			want: []error{
+				ValidationError{Field: "code", Message: defaultMessage("InvalidMaxNumber", 999), Code: "max"},
+				ValidationError{Field: "tags.env", Message: defaultMessage("InvalidMaxString", 5), Code: "max"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate([]byte(tt.jsonData), new(createObject))
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,440 @@
+package jsonValidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// kind is the JSON type of a node.
+type kind uint8
+
+const (
+	kindNull kind = iota
+	kindBool
+	kindNumber
+	kindString
+	kindArray
+	kindObject
+)
+
+func (k kind) String() string {
+	switch k {
+	case kindBool:
+		return "bool"
+	case kindNumber:
+		return "number"
+	case kindString:
+		return "string"
+	case kindArray:
+		return "array"
+	case kindObject:
+		return "object"
+	default:
+		return "null"
+	}
+}
+
+// node is a JSON value inside a document. The nodes are stored in pre-order in the document arena, so the
+// value of an object member is always the node right after its key.
+type node struct {
+	kind  kind
+	start int // Offset of the first byte of the value.
+	end   int // Offset after the last byte of the value.
+	first int // Index of the first element (arrays) or of the first member key (objects), 0 if none.
+	next  int // Index of the next element or member key, 0 if none.
+	count int // Number of elements or members.
+}
+
+// document is an indexed arena of the JSON values of a payload. The values are only decoded when they are
+// needed and keep their original byte offsets.
+type document struct {
+	data  []byte
+	nodes []node
+}
+
+// syntaxError is returned when the json data is not valid, with the offset where the error was found.
+type syntaxError struct {
+	offset int
+}
+
+func (se *syntaxError) Error() string {
+	return "invalid json at offset " + strconv.Itoa(se.offset)
+}
+
+func parseDocument(data []byte) (*document, error) {
+
+	// 1) Check the syntax, the scanner below relies on valid json.
+	if !json.Valid(data) {
+		offset := len(data)
+		var jsonErr *json.SyntaxError
+		if err := json.Unmarshal(data, new(json.RawMessage)); errors.As(err, &jsonErr) {
+			offset = int(jsonErr.Offset)
+
+			// The offset of an invalid character is the one after the character.
+			if strings.HasPrefix(jsonErr.Error(), "invalid character") {
+				offset--
+			}
+		}
+		return nil, &syntaxError{offset: offset}
+	}
+
+	// 2) Scan the values into the arena.
+	d := &document{data: data, nodes: make([]node, 0, 16)}
+	d.parseValue(d.skipWhitespace(0))
+
+	// 3) Return the document.
+	return d, nil
+}
+
+// readDocument parses the json data as it is read from r, token by token, so the reading stops at the first syntax
+// error instead of reading the rest of the payload. The document keeps the bytes read, its nodes being offsets into
+// them, and an empty payload is read as an empty object. The bytes read are returned as they are (e.g. empty for an
+// empty payload), also with the errors, the errors reading r being returned as they are.
+func readDocument(r io.Reader) ([]byte, *document, error) {
+
+	// 1) Initialize the decoder, keeping the bytes it reads.
+	var data bytes.Buffer
+	decoder := json.NewDecoder(io.TeeReader(r, &data))
+	decoder.UseNumber()
+	d := &document{nodes: make([]node, 0, 16)}
+
+	// 2) Add each token to the arena, the open arrays and objects being kept in a stack.
+	type container struct {
+		index int  // Index of the array or object node.
+		last  int  // Index of its last element or member key, 0 if none.
+		key   bool // Whether the next token of the object is a member key.
+	}
+	var open []*container
+	end := 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF && len(d.nodes) == 0 {
+			empty, err := parseDocument([]byte("{}"))
+			return data.Bytes(), empty, err
+		}
+		if err != nil {
+			return data.Bytes(), nil, readError(err, data.Bytes())
+		}
+		start := end
+		for start < data.Len() && bytes.IndexByte([]byte(" \t\n\r,:"), data.Bytes()[start]) >= 0 {
+			start++
+		}
+		end = int(decoder.InputOffset())
+
+		// 2.1) Close the array or object, or add the member key.
+		if delim, ok := token.(json.Delim); ok && (delim == '}' || delim == ']') {
+			d.nodes[open[len(open)-1].index].end = end
+			open = open[:len(open)-1]
+		} else if len(open) > 0 && open[len(open)-1].key {
+			parent := open[len(open)-1]
+			d.nodes = append(d.nodes, node{kind: kindString, start: start, end: end})
+			d.link(parent.index, &parent.last, len(d.nodes)-1)
+			parent.key = false
+			continue
+		} else {
+
+			// 2.2) Add the value, linked to its array (the values of the members follow their key).
+			index := len(d.nodes)
+			d.nodes = append(d.nodes, node{kind: tokenKind(token), start: start, end: end})
+			if len(open) > 0 {
+				if parent := open[len(open)-1]; d.nodes[parent.index].kind == kindArray {
+					d.link(parent.index, &parent.last, index)
+				} else {
+					parent.key = true
+				}
+			}
+			if _, ok := token.(json.Delim); ok {
+				open = append(open, &container{index: index, key: token == json.Delim('{')})
+			}
+		}
+
+		// 2.3) Stop after the root value, nothing but whitespace can follow it.
+		if len(open) == 0 {
+			if _, err := decoder.Token(); err != io.EOF {
+				return data.Bytes(), nil, readError(err, data.Bytes())
+			}
+			break
+		}
+	}
+
+	// 3) Return the document.
+	d.data = data.Bytes()
+	return d.data, d, nil
+}
+
+// readError returns the error of a decoder reading a document: the syntax errors and the truncated payloads as the
+// syntaxError parseDocument finds in the bytes read, which hold the invalid character, and the errors of the reader as
+// they are.
+func readError(err error, data []byte) error {
+	var jsonErr *json.SyntaxError
+	if err == nil || errors.As(err, &jsonErr) || err == io.EOF || err == io.ErrUnexpectedEOF {
+		if _, err = parseDocument(data); err == nil {
+			err = &syntaxError{offset: len(data)}
+		}
+	}
+	return err
+}
+
+// link appends the node to the elements or the member keys of the array or object.
+func (d *document) link(parent int, last *int, index int) {
+	if *last == 0 {
+		d.nodes[parent].first = index
+	} else {
+		d.nodes[*last].next = index
+	}
+	*last = index
+	d.nodes[parent].count++
+}
+
+func tokenKind(token json.Token) kind {
+	switch token.(type) {
+	case json.Delim:
+		if token == json.Delim('{') {
+			return kindObject
+		}
+		return kindArray
+	case bool:
+		return kindBool
+	case json.Number:
+		return kindNumber
+	case string:
+		return kindString
+	}
+	return kindNull
+}
+
+func (d *document) skipWhitespace(offset int) int {
+	for offset < len(d.data) {
+		switch d.data[offset] {
+		case ' ', '\t', '\n', '\r':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// parseValue scans the value starting at offset and returns its node index and the offset after it.
+func (d *document) parseValue(offset int) (int, int) {
+
+	// 1) Add the node to the arena.
+	index := len(d.nodes)
+	d.nodes = append(d.nodes, node{start: offset})
+
+	// 2) Scan the value according to its first byte.
+	switch d.data[offset] {
+	case '{':
+		d.nodes[index].kind = kindObject
+		offset = d.skipWhitespace(offset + 1)
+		last := 0
+		for d.data[offset] != '}' {
+			key, end := d.parseValue(offset)
+			offset = d.skipWhitespace(end)
+			_, end = d.parseValue(d.skipWhitespace(offset + 1))
+			offset = d.skipWhitespace(end)
+			if last == 0 {
+				d.nodes[index].first = key
+			} else {
+				d.nodes[last].next = key
+			}
+			last = key
+			d.nodes[index].count++
+			if d.data[offset] == ',' {
+				offset = d.skipWhitespace(offset + 1)
+			}
+		}
+		offset++
+	case '[':
+		d.nodes[index].kind = kindArray
+		offset = d.skipWhitespace(offset + 1)
+		last := 0
+		for d.data[offset] != ']' {
+			element, end := d.parseValue(offset)
+			offset = d.skipWhitespace(end)
+			if last == 0 {
+				d.nodes[index].first = element
+			} else {
+				d.nodes[last].next = element
+			}
+			last = element
+			d.nodes[index].count++
+			if d.data[offset] == ',' {
+				offset = d.skipWhitespace(offset + 1)
+			}
+		}
+		offset++
+	case '"':
+		d.nodes[index].kind = kindString
+		offset++
+		for d.data[offset] != '"' {
+			if d.data[offset] == '\\' {
+				offset++
+			}
+			offset++
+		}
+		offset++
+	case 't':
+		d.nodes[index].kind = kindBool
+		offset += len("true")
+	case 'f':
+		d.nodes[index].kind = kindBool
+		offset += len("false")
+	case 'n':
+		d.nodes[index].kind = kindNull
+		offset += len("null")
+	default:
+		d.nodes[index].kind = kindNumber
+		for offset < len(d.data) && isNumberByte(d.data[offset]) {
+			offset++
+		}
+	}
+
+	// 3) Close the node.
+	d.nodes[index].end = offset
+	return index, offset
+}
+
+// position returns the line and column (both starting at 1) of a byte offset.
+func position(data []byte, offset int) (int, int) {
+	if offset > len(data) {
+		offset = len(data)
+	}
+	line := 1 + bytes.Count(data[:offset], []byte("\n"))
+	lineStart := bytes.LastIndexByte(data[:offset], '\n') + 1
+	return line, 1 + utf8.RuneCount(data[lineStart:offset])
+}
+
+func isNumberByte(b byte) bool {
+	return '0' <= b && b <= '9' || b == '-' || b == '+' || b == '.' || b == 'e' || b == 'E'
+}
+
+func (d *document) kind(i int) kind {
+	return d.nodes[i].kind
+}
+
+func (d *document) raw(i int) []byte {
+	return d.data[d.nodes[i].start:d.nodes[i].end]
+}
+
+func (d *document) stringValue(i int) string {
+	raw := d.raw(i)
+	for _, b := range raw {
+		if b == '\\' {
+			var value string
+			_ = json.Unmarshal(raw, &value)
+			return value
+		}
+	}
+	return string(raw[1 : len(raw)-1])
+}
+
+func (d *document) numberValue(i int) float64 {
+	value, _ := strconv.ParseFloat(string(d.raw(i)), 64)
+	return value
+}
+
+func (d *document) boolValue(i int) bool {
+	return d.data[d.nodes[i].start] == 't'
+}
+
+// elements returns the node indexes of the elements of an array.
+func (d *document) elements(i int) []int {
+	elements := make([]int, 0, d.nodes[i].count)
+	for e := d.nodes[i].first; e != 0; e = d.nodes[e].next {
+		elements = append(elements, e)
+	}
+	return elements
+}
+
+// keys returns the node indexes of the member keys of an object, the value of each member is the node after its key.
+func (d *document) keys(i int) []int {
+	keys := make([]int, 0, d.nodes[i].count)
+	for k := d.nodes[i].first; k != 0; k = d.nodes[k].next {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// value decodes the node into the same values encoding/json would decode into an any.
+func (d *document) value(i int) any {
+	switch d.kind(i) {
+	case kindBool:
+		return d.boolValue(i)
+	case kindNumber:
+		return d.numberValue(i)
+	case kindString:
+		return d.stringValue(i)
+	case kindArray:
+		values := make([]any, 0, d.nodes[i].count)
+		for _, e := range d.elements(i) {
+			values = append(values, d.value(e))
+		}
+		return values
+	case kindObject:
+		values := make(map[string]any, d.nodes[i].count)
+		for k := d.nodes[i].first; k != 0; k = d.nodes[k].next {
+			values[d.stringValue(k)] = d.value(k + 1)
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// lookup returns the node index of a field path (as reported in the errors, e.g. "personList[0].name"),
+// or -1 if the path is not in the document.
+func (d *document) lookup(path string) int {
+
+	// 1) The "json" path is the whole document.
+	i := 0
+	if path == "json" || path == "" {
+		return i
+	}
+
+	// 2) Walk each path segment.
+	for _, segment := range strings.Split(path, ".") {
+
+		// 2.1) Split the member name from the list indexes.
+		name, indexes, _ := strings.Cut(segment, "[")
+		if name != "" {
+			if i = d.member(i, name); i < 0 {
+				return -1
+			}
+		}
+
+		// 2.2) Walk the list indexes.
+		if indexes == "" {
+			continue
+		}
+		for _, index := range strings.Split(strings.TrimSuffix(indexes, "]"), "][") {
+			position, err := strconv.Atoi(index)
+			if err != nil || d.kind(i) != kindArray || position < 0 || position >= d.nodes[i].count {
+				return -1
+			}
+			i = d.elements(i)[position]
+		}
+	}
+
+	// 3) Return the node index.
+	return i
+}
+
+// member returns the node index of the value of an object member, or -1 if the member does not exist.
+func (d *document) member(i int, name string) int {
+	if d.kind(i) != kindObject {
+		return -1
+	}
+	member := -1
+	for k := d.nodes[i].first; k != 0; k = d.nodes[k].next {
+		if d.stringValue(k) == name {
+			member = k + 1
+		}
+	}
+	return member
+}
@@ -0,0 +1,146 @@
+package jsonValidator
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		jsonData string
+	}{
+		{"test_object", "{\"name\": \"Daniel\", \"code\": 123, \"price\": -1.5e3, \"successful\": true, \"empty\": null}"},
+		{"test_nested", " { \"person\" : { \"name\" : \"Daniel\" , \"tags\" : [ ] } , \"list\" : [ {}, [1, [2]], \"a\" ] } "},
+		{"test_escapes", "{\"name\": \"Dan\\\"iel \\u00e9\\\\\", \"key\\n\": false}"},
+		{"test_array", "[1, \"2\", true, null, {\"a\": []}]"},
+		{"test_scalar", "12.50"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var want any
+			if err := json.Unmarshal([]byte(tt.jsonData), &want); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			d, err := parseDocument([]byte(tt.jsonData))
+			if err != nil {
+				t.Fatalf("parseDocument() error = %v", err)
+			}
+			if got := d.value(0); !reflect.DeepEqual(got, want) {
+				t.Errorf("parseDocument() = %v, want %v", got, want)
+			}
+			for i := range d.nodes {
+				if !json.Valid(d.raw(i)) {
+					t.Errorf("parseDocument() node %d has invalid offsets %q", i, d.raw(i))
+				}
+			}
+		})
+	}
+}
+
+func TestParseDocument_InvalidJson(t *testing.T) {
+	for _, jsonData := range []string{"", "{", "{\"name\": \"Daniel\",}", "[1 2]", "nul"} {
+		if _, err := parseDocument([]byte(jsonData)); err == nil {
+			t.Errorf("parseDocument(%q) error = nil, want an error", jsonData)
+		}
+	}
+}
+
+func TestReadDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		jsonData string
+	}{
+		{"test_object", "{\"name\": \"Daniel\", \"code\": 123, \"price\": -1.5e3, \"successful\": true, \"empty\": null}"},
+		{"test_nested", " { \"person\" : { \"name\" : \"Daniel\" , \"tags\" : [ ] } , \"list\" : [ {}, [1, [2]], \"a\" ] } "},
+		{"test_escapes", "{\"name\": \"Dan\\\"iel \\u00e9\\\\\", \"key\\n\": false}"},
+		{"test_array", "[1, \"2\", true, null, {\"a\": []}]"},
+		{"test_scalar", "1e400"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, _ := parseDocument([]byte(tt.jsonData))
+			data, got, err := readDocument(strings.NewReader(tt.jsonData))
+			if err != nil {
+				t.Fatalf("readDocument() error = %v", err)
+			}
+			if string(data) != tt.jsonData || !reflect.DeepEqual(got.nodes, want.nodes) {
+				t.Errorf("readDocument() = %v, want %v", got.nodes, want.nodes)
+			}
+		})
+	}
+}
+
+func TestReadDocument_InvalidJson(t *testing.T) {
+	for _, jsonData := range []string{"{", "{\"name\": \"Daniel\",}", "[1 2]", "[1,]", "nul", "{1: 2}", "{} {}", "{}x", "\n {\"a\":\n x}"} {
+		_, wantErr := parseDocument([]byte(jsonData))
+		if _, _, err := readDocument(strings.NewReader(jsonData)); !reflect.DeepEqual(err, wantErr) {
+			t.Errorf("readDocument(%q) error = %v, want %v", jsonData, err, wantErr)
+		}
+	}
+}
+
+func TestOffsets_OffsetOf(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string"`
+	}
+	type createObject struct {
+		Code       *int     `validations:"type=int"`
+		Owners     []string `validations:"type=[]string"`
+		Person     *Person  `validations:"type=struct"`
+		PersonList []Person `validations:"type=[]struct"`
+	}
+	jsonData := []byte("{\"code\": \"abc\", \"owners\": [\"a\", 1], \"person\": {\"name\": \"Daniel\"}, \"personList\": [{}, {\"name\": \"Jose\"}]}")
+	offsets := new(Offsets)
+	Validate(jsonData, new(createObject), WithOffsets(offsets))
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"json", string(jsonData)},
+		{"code", "\"abc\""},
+		{"owners", "[\"a\", 1]"},
+		{"owners[1]", "1"},
+		{"person.name", "\"Daniel\""},
+		{"personList[1].name", "\"Jose\""},
+		{"personList[2].name", ""},
+		{"surname", ""},
+		{"code.name", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			start, end := offsets.OffsetOf(tt.path)
+			if tt.want == "" {
+				if start != -1 || end != -1 {
+					t.Errorf("OffsetOf() = %v, %v, want -1, -1", start, end)
+				}
+				return
+			}
+			if start < 0 || string(jsonData[start:end]) != tt.want {
+				t.Errorf("OffsetOf() = %v, %v, want the span of %v", start, end, tt.want)
+			}
+		})
+	}
+}
+
+func TestOffsets_OffsetOfWithPathPrefix(t *testing.T) {
+	type createObject struct {
+		Code *int `validations:"type=int"`
+	}
+	jsonData := []byte("{\"code\": 1}")
+	offsets := new(Offsets)
+	Validate(jsonData, new(createObject), WithOffsets(offsets), WithPathPrefix("body"))
+
+	if start, end := offsets.OffsetOf("body.code"); start < 0 || string(jsonData[start:end]) != "1" {
+		t.Errorf("OffsetOf() = %v, %v, want the span of 1", start, end)
+	}
+	if start, end := offsets.OffsetOf("body"); start != 0 || end != len(jsonData) {
+		t.Errorf("OffsetOf() = %v, %v, want the span of the body", start, end)
+	}
+	if start, end := offsets.OffsetOf("code"); start != -1 || end != -1 {
+		t.Errorf("OffsetOf() = %v, %v, want -1, -1", start, end)
+	}
+}
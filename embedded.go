@@ -0,0 +1,94 @@
+package jsonValidator
+
+import "reflect"
+
+// promotedField is a field of a form, or a field promoted from one of its embedded structs.
+type promotedField struct {
+	reflect.StructField
+
+	// depth is the embedding depth of the field, 0 for the fields declared on the form.
+	depth int
+
+	// index is the index path of the field in the form.
+	index []int
+
+	// indirect is set when the field is promoted through an embedded pointer, so it has no fixed offset in the form.
+	indirect bool
+}
+
+// formFields returns the fields of a form type, with the fields of its embedded structs (or pointers to structs)
+// without validations tag promoted like encoding/json does: a field hides the fields of the same name of the deeper
+// embedded structs, and the fields of the same name at the same depth hide each other. The embedded structs with a
+// validations tag are regular fields.
+func formFields(formType reflect.Type, syntax tagSyntax) []promotedField {
+
+	// 1) Collect the fields of the form and of its embedded structs, with their offset in the form.
+	var candidates []promotedField
+	var collect func(structType reflect.Type, offset uintptr, parent []int, indirect bool, visited map[reflect.Type]bool)
+	collect = func(structType reflect.Type, offset uintptr, parent []int, indirect bool, visited map[reflect.Type]bool) {
+		if visited[structType] {
+			return
+		}
+		visited[structType] = true
+		for i := 0; i < structType.NumField(); i++ {
+			field := structType.Field(i)
+			field.Offset += offset
+			index := append(append([]int(nil), parent...), i)
+			embeddedType, embeddedPointer := field.Type, false
+			if embeddedType.Kind() == reflect.Pointer {
+				embeddedType, embeddedPointer = embeddedType.Elem(), true
+			}
+			if field.Anonymous && embeddedType.Kind() == reflect.Struct && field.Tag.Get(syntax.name) == "" {
+				if !embeddedPointer || field.IsExported() {
+					collect(embeddedType, field.Offset, index, indirect || embeddedPointer, visited)
+				}
+				continue
+			}
+			candidates = append(candidates, promotedField{StructField: field, depth: len(parent), index: index, indirect: indirect})
+		}
+		delete(visited, structType)
+	}
+	collect(formType, 0, nil, false, make(map[reflect.Type]bool))
+
+	// 2) Keep the shallowest field of each name, unless several fields share its depth.
+	shallowest := make(map[string]int)
+	count := make(map[string]int)
+	for _, field := range candidates {
+		name := LowerCase(field.Name)
+		if depth, ok := shallowest[name]; !ok || field.depth < depth {
+			shallowest[name], count[name] = field.depth, 0
+		}
+		if field.depth == shallowest[name] {
+			count[name]++
+		}
+	}
+	fields := make([]promotedField, 0, len(candidates))
+	for _, field := range candidates {
+		name := LowerCase(field.Name)
+		if field.depth == shallowest[name] && count[name] == 1 {
+			fields = append(fields, field)
+		}
+	}
+
+	// 3) Return the fields.
+	return fields
+}
+
+// formField returns the field of the validations in the form. The promoted fields are reached through their index
+// path, allocating the nil embedded struct pointers they are promoted through.
+func formField(form reflect.Value, validations *Validations) reflect.Value {
+	if validations.index == nil {
+		return form.FieldByName(validations.structField)
+	}
+	field := form
+	for i, index := range validations.index {
+		if i > 0 && field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				field.Set(reflect.New(field.Type().Elem()))
+			}
+			field = field.Elem()
+		}
+		field = field.Field(index)
+	}
+	return field
+}
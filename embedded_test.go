@@ -0,0 +1,148 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+type BaseFields struct {
+	Id        *int    `validations:"type=int;required=true;min=1"`
+	CreatedBy *string `validations:"type=string"`
+	Name      *string `validations:"type=string;max=3"`
+}
+
+type AuditFields struct {
+	UpdatedBy *string `validations:"type=string;required=true"`
+}
+
+type baseNote struct {
+	Note *string `validations:"type=string;max=5"`
+}
+
+func TestValidate_Embedded(t *testing.T) {
+	type createObject struct {
+		BaseFields
+		*AuditFields
+		baseNote
+		Name *string `validations:"type=string;max=10"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+		wantForm createObject
+	}{
+		{
+			name:     "test_embedded",
+			jsonData: []byte(`{"id": 1, "createdBy": "ana", "updatedBy": "bob", "note": "hi", "name": "long name"}`),
+			want:     nil,
+			wantForm: createObject{
+				BaseFields:  BaseFields{Id: toIntPointer(1), CreatedBy: toStringPointer("ana")},
+				AuditFields: &AuditFields{UpdatedBy: toStringPointer("bob")},
+				baseNote:    baseNote{Note: toStringPointer("hi")},
+				Name:        toStringPointer("long name"),
+			},
+		},
+		{
+			name:     "test_embedded_invalid",
+			jsonData: []byte(`{"id": 0, "note": "too long"}`),
+			want: []error{
+				ValidationError{Field: "id", Message: defaultMessage("InvalidMinNumber", 1), Code: "min"},
+				ValidationError{Field: "note", Message: defaultMessage("InvalidMaxString", 5), Code: "max"},
+				ValidationError{Field: "updatedBy", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+			wantForm: createObject{BaseFields: BaseFields{Id: toIntPointer(0)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := Validate(tt.jsonData, form)
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			if tt.want == nil && !reflect.DeepEqual(*form, tt.wantForm) {
+				t.Errorf("Validate() form = %+v, want %+v", *form, tt.wantForm)
+			}
+		})
+	}
+}
+
+func TestValidate_EmbeddedConflict(t *testing.T) {
+	type First struct {
+		Code *string `validations:"type=string"`
+	}
+	type Second struct {
+		Code *int `validations:"type=int"`
+	}
+	type createObject struct {
+		First
+		Second
+	}
+	want := []error{ValidationError{Field: "code", Message: DefaultMessages["InvalidField"], Code: "unknown_field"}}
+	if got := Validate([]byte(`{"code": "a"}`), new(createObject)); !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate() = %v, want %v", got, want)
+	}
+}
+
+func TestSchemaWithRule_Embedded(t *testing.T) {
+	type createObject struct {
+		BaseFields
+		*AuditFields
+		baseNote
+		Name *string `validations:"type=string;max=10"`
+	}
+	schema, err := Compile(createObject{})
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	schema = schema.WithRule("id", "max=100").WithRule("createdBy", "max=5").WithRule("updatedBy", "max=5").WithRule("note", "max=3")
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+		wantForm createObject
+	}{
+		{
+			name:     "test_embedded_with_rule",
+			jsonData: []byte(`{"id": 1, "createdBy": "ana", "updatedBy": "bob", "note": "hi", "name": "long name"}`),
+			want:     nil,
+			wantForm: createObject{
+				BaseFields:  BaseFields{Id: toIntPointer(1), CreatedBy: toStringPointer("ana")},
+				AuditFields: &AuditFields{UpdatedBy: toStringPointer("bob")},
+				baseNote:    baseNote{Note: toStringPointer("hi")},
+				Name:        toStringPointer("long name"),
+			},
+		},
+		{
+			name:     "test_embedded_with_rule_errors",
+			jsonData: []byte(`{"id": 101, "updatedBy": "robert", "note": "hello"}`),
+			want: []error{
+				ValidationError{Field: "id", Message: defaultMessage("InvalidMaxNumber", 100), Code: "max"},
+				ValidationError{Field: "updatedBy", Message: defaultMessage("InvalidMaxString", 5), Code: "max"},
+				ValidationError{Field: "note", Message: defaultMessage("InvalidMaxString", 3), Code: "max"},
+			},
+			wantForm: createObject{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := schema.Validate(tt.jsonData, form)
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(*form, tt.wantForm) {
+				t.Errorf("Validate() form = %+v, want %+v", *form, tt.wantForm)
+			}
+		})
+	}
+}
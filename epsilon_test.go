@@ -0,0 +1,58 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_Epsilon(t *testing.T) {
+	type createObject struct {
+		Rate   *float64           `validations:"type=float;choices=0.1,0.2;epsilon=1e-9"`
+		Ratio  *float64           `validations:"type=float;min=0.3;max=0.7;epsilon=1e-9"`
+		Rates  []float64          `validations:"type=[]float;choices=0.1,0.2;epsilon=1e-9"`
+		Prices map[string]float64 `validations:"type=map[string]float;choices=2,3;epsilon=0.01"`
+		Exact  *float64           `validations:"type=float;choices=0.3"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_epsilon_within",
+			jsonData: []byte("{\"rate\": 0.2000000001, \"ratio\": \"0.7000000001\", \"rates\": [0.1, 0.09999999999], \"prices\": {\"eur\": 2.005}}"),
+		},
+		{
+			name:     "test_epsilon_sum",
+			jsonData: []byte("{\"ratio\": 0.30000000000000004, \"rate\": 0.30000000000000004}"),
+			want: []error{
+				ValidationError{Field: "rate", Message: defaultMessage("InvalidChoice", 0.30000000000000004, []any{0.1, 0.2}), Code: "choice"},
+			},
+		},
+		{
+			name:     "test_epsilon_outside",
+			jsonData: []byte("{\"rate\": 0.2001, \"ratio\": 0.2999, \"rates\": [0.21], \"prices\": {\"eur\": 2.02}, \"exact\": 0.30000000000000004}"),
+			want: []error{
+				ValidationError{Field: "rate", Message: defaultMessage("InvalidChoice", 0.2001, []any{0.1, 0.2}), Code: "choice"},
+				ValidationError{Field: "ratio", Message: defaultMessage("InvalidMinNumber", 0.3), Code: "min"},
+				ValidationError{Field: "rates[0]", Message: defaultMessage("InvalidChoice", 0.21, []any{0.1, 0.2}), Code: "choice"},
+				ValidationError{Field: "prices.eur", Message: defaultMessage("InvalidChoice", 2.02, []any{2.0, 3.0}), Code: "choice"},
+				ValidationError{Field: "exact", Message: defaultMessage("InvalidChoice", 0.30000000000000004, []any{0.3}), Code: "choice"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_Exclude(t *testing.T) {
+	type createObject struct {
+		Username *string           `validations:"type=string;exclude=admin,root,system"`
+		Code     *int              `validations:"type=int;exclude=0,999"`
+		Ratio    *float64          `validations:"type=float;exclude=0.3;epsilon=1e-9"`
+		Aliases  []string          `validations:"type=[]string;exclude=admin,root"`
+		Labels   map[string]string `validations:"type=map[string]string;exclude=none"`
+	}
+	tests := []struct {
+		name     string
+		jsonData string
+		want     []error
+	}{
+		{
+			name:     "test_exclude",
+			jsonData: `{"username": "daniel", "code": 1, "ratio": 0.5, "aliases": ["dani"], "labels": {"env": "prod"}}`,
+			want:     nil,
+		},
+		{
+			name:     "test_exclude_errors",
+			jsonData: `{"username": "root", "code": 999, "ratio": 0.30000000000000004, "aliases": ["dani", "admin"], "labels": {"env": "none"}}`,
+			want: []error{
+				ValidationError{Field: "username", Message: defaultMessage("ExcludedValue", "root"), Code: "excluded"},
+				ValidationError{Field: "code", Message: defaultMessage("ExcludedValue", 999), Code: "excluded"},
+				ValidationError{Field: "ratio", Message: defaultMessage("ExcludedValue", 0.30000000000000004), Code: "excluded"},
+				ValidationError{Field: "aliases[1]", Message: defaultMessage("ExcludedValue", "admin"), Code: "excluded"},
+				ValidationError{Field: "labels.env", Message: defaultMessage("ExcludedValue", "none"), Code: "excluded"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate([]byte(tt.jsonData), new(createObject))
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareSchemas_Exclude(t *testing.T) {
+	type v1 struct {
+		Username *string `validations:"type=string;exclude=admin,root"`
+	}
+	type v2 struct {
+		Username *string `validations:"type=string;exclude=admin,root,system"`
+	}
+	type v3 struct {
+		Username *string `validations:"type=string;exclude=admin"`
+	}
+	tests := []struct {
+		name    string
+		oldForm any
+		newForm any
+		want    []Change
+	}{
+		{
+			name:    "test_exclude_tightened",
+			oldForm: v1{},
+			newForm: v2{},
+			want:    []Change{{Field: "username", Kind: "tightened", Rule: "exclude", Old: []any{"admin", "root"}, New: []any{"admin", "root", "system"}, Breaking: true}},
+		},
+		{
+			name:    "test_exclude_loosened",
+			oldForm: v1{},
+			newForm: v3{},
+			want:    []Change{{Field: "username", Kind: "loosened", Rule: "exclude", Old: []any{"admin", "root"}, New: []any{"admin"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareSchemas(tt.oldForm, tt.newForm); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CompareSchemas() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
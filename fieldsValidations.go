@@ -1,34 +1,64 @@
 package jsonValidator
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"net/mail"
+	"net/url"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unsafe"
 )
 
-func getValidations(formValue reflect.Value) map[string]*Validations {
+// validationsCache caches the validations parsed from the tags of each form type and tag syntax.
+var validationsCache sync.Map
+
+type validationsKey struct {
+	formType reflect.Type
+	syntax   tagSyntax
+}
+
+// getValidations returns the validations of the form type, parsing its tags on the first call only. The returned
+// validations are shared between the validations and must not be modified.
+func getValidations(formValue reflect.Value, syntax tagSyntax) map[string]*Validations {
+	key := validationsKey{formType: formValue.Type(), syntax: syntax}
+	if cached, ok := validationsCache.Load(key); ok {
+		return cached.(map[string]*Validations)
+	}
+	validationsMap := parseValidations(formValue.Type(), syntax)
+	validationsCache.Store(key, validationsMap)
+	return validationsMap
+}
+
+func parseValidations(formType reflect.Type, syntax tagSyntax) map[string]*Validations {
 
 	// 1) Initialize validations map and required fields map
 	validationsMap := make(map[string]*Validations)
 
-	// 2) Iterate over the form value.
-	for i := 0; i < formValue.NumField(); i++ {
-
-		// 2.1) Get field from form value.
-		field := formValue.Type().Field(i)
+	// 2) Iterate over the fields of the form type, including the ones promoted from its embedded structs.
+	for _, field := range formFields(formType, syntax) {
 
-		// 2.2) Get the validation using the tag "validations".
-		validationsTag := field.Tag.Get(DefaultTagName)
+		// 2.1) Get the validation using the tag "validations".
+		validationsTag := field.Tag.Get(syntax.name)
 
-		// 2.3) Split the validations in the tag by ";".
-		validationsSplit := strings.Split(validationsTag, DefaultSeparator)
+		// 2.2) Split the validations in the tag by ";".
+		validationsSplit := strings.Split(validationsTag, syntax.separator)
 
-		// 2.4) Parse validations tags
-		validations := parseValidationTags(validationsSplit)
+		// 2.3) Parse validations tags, without the rules guarded by a flag. The promoted fields are set through their index
+		// path, and through their offset only when they are not promoted through a pointer.
+		rules, flagged := flaggedRules(validationsSplit, nil)
+		validations := parseValidationTags(rules, syntax)
+		validations.flagged = flagged
+		validations.bindField(field)
 
-		// 2.5) Update validations map with the validations from this field
+		// 2.4) Update validations map with the validations from this field
 		validationsMap[LowerCase(field.Name)] = validations
 	}
 
@@ -37,62 +67,232 @@ func getValidations(formValue reflect.Value) map[string]*Validations {
 
 }
 
-func parseValidationTags(validationsSplit []string) *Validations {
+// bindField sets the metadata binding the values into the field of the validations: its name, its index path and offset
+// in the form, and how its type relates to the type of the validations (a value field, a double pointer or a sized
+// integer).
+func (validations *Validations) bindField(field promotedField) {
+	validations.structField = field.Name
+	if field.depth > 0 {
+		validations.index, validations.indirect = field.index, field.indirect
+	}
+	validations.offset, validations.unsafeSet = field.Offset, field.Type == pointerTypes[validations.Type] && !field.indirect
+	if intType := structType(field.Type); (validations.Type == "int" || validations.Type == "[]int") && isSizedInt(intType) {
+		validations.intType = intType
+	}
+	validations.valueField = pointerTypes[validations.Type] != nil && field.Type == pointerTypes[validations.Type].Elem()
+	validations.nullField = pointerTypes[validations.Type] != nil && field.Type == reflect.PointerTo(pointerTypes[validations.Type])
+	if validations.Type == "int" && validations.intType != nil {
+		validations.valueField = field.Type == validations.intType
+		validations.nullField = field.Type == reflect.PointerTo(reflect.PointerTo(validations.intType))
+	}
+	if mapType := structType(field.Type); validations.Type == "map[string]int" && mapType.Kind() == reflect.Map && isSizedInt(structType(mapType.Elem())) {
+		validations.Values.intType = structType(mapType.Elem())
+	}
+}
+
+// flaggedRules returns the rules of the tags that apply with the enabled flags. The rules after a "flag=name" only
+// apply when the flag is enabled, until the next "flag=" (an empty "flag=" ends the guarded rules). It also reports
+// whether the tags have guarded rules.
+func flaggedRules(validationsSplit []string, flags map[string]bool) ([]string, bool) {
+
+	// 1) Initialize the rules list.
+	var rules []string
+	var flagged bool
+	enabled := true
+
+	// 2) Iterate over the tags, skipping the rules of the disabled flags.
+	for _, validation := range validationsSplit {
+		if flag, exists := strings.CutPrefix(validation, "flag="); exists {
+			flagged = true
+			enabled = flag == "" || flags[flag]
+			continue
+		}
+		if enabled {
+			rules = append(rules, validation)
+		}
+	}
+
+	// 3) Return the rules.
+	return rules, flagged
+}
+
+func parseValidationTags(validationsSplit []string, syntax tagSyntax) *Validations {
 
-	// 1) Initialize the validation instance.
-	validations := new(Validations)
+	// 1) Initialize the validation instance, without a declared scale.
+	validations := &Validations{Scale: -1}
 
 	// 2) Iterate over the validationSplit list to update the validations instance.
 	for _, validation := range validationsSplit {
 
 		// 2.1) Case: Required.
 		if value, exists := strings.CutPrefix(validation, "required="); exists {
-			if value == "true" {
-				validations.Required = true
-			}
+			validations.Required = value == "true"
 		}
 
-		// 2.2) Case: Type.
+		// 2.2) Case: Conditional requirements.
+		if value, exists := strings.CutPrefix(validation, "required_if="); exists {
+			validations.RequiredIf = parseCondition(value, syntax)
+		}
+		if value, exists := strings.CutPrefix(validation, "required_unless="); exists {
+			validations.RequiredUnless = parseCondition(value, syntax)
+		}
+		if value, exists := strings.CutPrefix(validation, "required_with="); exists && value != "" {
+			validations.RequiredWith = strings.Split(value, syntax.choicesSeparator)
+		}
+
+		// 2.3) Case: Strict and nullable.
+		if value, exists := strings.CutPrefix(validation, "strict="); exists {
+			validations.Strict = value == "true"
+		}
+		if value, exists := strings.CutPrefix(validation, "nullable="); exists {
+			validations.Nullable = value == "true"
+		}
+
+		// 2.4) Case: Type.
 		if value, exists := strings.CutPrefix(validation, "type="); exists {
 			switch value {
-			case "string", "int", "float", "bool", "struct", "[]string", "[]int", "[]float", "[]struct":
+			case "string", "int", "float", "bool", "datetime", "geo", "money", "struct", "[]string", "[]int", "[]float", "[]struct", "file",
+				"map[string]string", "map[string]int", "map[string]float", "map[string]bool", "map[string]struct":
 				validations.Type = value
 			}
 		}
 
-		// 2.3) Case: Min.
+		// 2.5) Case: Datetime and string formats, the string prefixes, the country of the subdivisions, the list of the
+		// allowed values, and the fields and operators of the expressions.
+		if value, exists := strings.CutPrefix(validation, "format="); exists {
+			_, isStringFormat := stringFormats[value]
+			switch {
+			case validations.Type == "datetime",
+				isStringFormat && (validations.Type == "string" || validations.Type == "[]string"),
+				(value == "sortexpr" || value == "filterexpr" || value == "datauri") && validations.Type == "string":
+				validations.Format = value
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "prefix="); exists && value != "" {
+			if validations.Type == "string" || validations.Type == "[]string" {
+				validations.Prefixes = strings.Split(value, syntax.choicesSeparator)
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "country="); exists && validations.Type == "string" {
+			validations.Country = value
+		}
+		if value, exists := strings.CutPrefix(validation, "inField="); exists {
+			switch validations.Type {
+			case "string", "int", "float":
+				validations.InField = value
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "fields="); exists && value != "" {
+			validations.Fields = strings.Split(value, syntax.choicesSeparator)
+		}
+		if value, exists := strings.CutPrefix(validation, "operators="); exists && value != "" {
+			validations.Operators = strings.Split(value, syntax.choicesSeparator)
+		}
+
+		// 2.6) Case: Geo precision, and the tolerance of the float comparisons.
+		if value, exists := strings.CutPrefix(validation, "precision="); exists {
+			if validations.Type == "geo" {
+				if precision, err := strconv.Atoi(value); err == nil {
+					validations.Precision = precision
+				}
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "epsilon="); exists {
+			if validations.Type == "float" || validations.Type == "[]float" {
+				if epsilon, err := strconv.ParseFloat(value, 64); err == nil && epsilon >= 0 && !math.IsInf(epsilon, 0) {
+					validations.Epsilon = epsilon
+				}
+			}
+		}
+
+		// 2.7) Case: Money scale and currencies.
+		if value, exists := strings.CutPrefix(validation, "scale="); exists {
+			if validations.Type == "money" {
+				if scale, err := strconv.Atoi(value); err == nil && scale >= 0 {
+					validations.Scale = scale
+				}
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "currencies="); exists {
+			if validations.Type == "money" && value != "" {
+				validations.Currencies = strings.Split(value, syntax.choicesSeparator)
+			}
+		}
+
+		// 2.8) Case: Min.
 		if value, exists := strings.CutPrefix(validation, "min="); exists {
 			switch validations.Type {
 			case "string", "int", "[]string", "[]int", "[]float", "[]struct":
 				if minL, err := strconv.ParseInt(value, 10, 0); err == nil {
 					validations.Min = float64(minL)
 				}
-			case "float":
+			case "float", "money":
 				if minL, err := strconv.ParseFloat(value, 0); err == nil {
 					validations.Min = minL
 				}
 			}
 		}
 
-		// 2.4) Case: Max.
+		// 2.9) Case: Max.
 		if value, exists := strings.CutPrefix(validation, "max="); exists {
 			switch validations.Type {
 			case "string", "int", "[]string", "[]int", "[]float", "[]struct":
 				if maxL, err := strconv.ParseInt(value, 10, 0); err == nil {
 					validations.Max = float64(maxL)
 				}
-			case "float":
+			case "float", "money":
 				if maxL, err := strconv.ParseFloat(value, 0); err == nil {
 					validations.Max = maxL
 				}
 			}
 		}
 
-		// 2.5) Case: Choices.
+		// 2.9.1) Case: Exact length of the strings and of the lists.
+		if value, exists := strings.CutPrefix(validation, "len="); exists {
+			switch validations.Type {
+			case "string", "[]string", "[]int", "[]float", "[]struct":
+				if length, err := strconv.Atoi(value); err == nil && length > 0 {
+					validations.Len = length
+				}
+			}
+		}
+
+		// 2.10) Case: Min and max keys of the maps, and min and max digits of the strings.
+		if value, exists := strings.CutPrefix(validation, "minKeys="); exists {
+			if minKeys, err := strconv.Atoi(value); err == nil && strings.HasPrefix(validations.Type, "map[") {
+				validations.MinKeys = minKeys
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "maxKeys="); exists {
+			if maxKeys, err := strconv.Atoi(value); err == nil && strings.HasPrefix(validations.Type, "map[") {
+				validations.MaxKeys = maxKeys
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "minDigits="); exists {
+			if minDigits, err := strconv.Atoi(value); err == nil && (validations.Type == "string" || validations.Type == "[]string") {
+				validations.MinDigits = minDigits
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "maxDigits="); exists {
+			if maxDigits, err := strconv.Atoi(value); err == nil && (validations.Type == "string" || validations.Type == "[]string") {
+				validations.MaxDigits = maxDigits
+			}
+		}
+
+		// 2.11) Case: Choices.
 		if value, exists := strings.CutPrefix(validation, "choices="); exists {
 			if value != "" {
 				var choices []any
-				for _, choice := range strings.Split(value, DefaultChoicesSeparator) {
+				var labels []string
+				var hasLabels bool
+				for _, choice := range strings.Split(value, syntax.choicesSeparator) {
+
+					// 2.11.1) Split the value from its label (e.g. "1:Low").
+					choice, label, hasLabel := strings.Cut(choice, syntax.choiceLabelSeparator)
+					hasLabels = hasLabels || hasLabel
+
+					// 2.11.2) Parse the value.
+					choicesCount := len(choices)
 					switch validations.Type {
 					case "string", "[]string":
 						choices = append(choices, choice)
@@ -105,101 +305,452 @@ func parseValidationTags(validationsSplit []string) *Validations {
 							choices = append(choices, floatChoice)
 						}
 					}
+					if len(choices) > choicesCount {
+						labels = append(labels, label)
+					}
 				}
 				validations.Choices = choices
+				if hasLabels {
+					validations.ChoiceLabels = labels
+				}
+			}
+		}
+
+		// 2.11.3) Case: Choices from a provider, resolved at validation time.
+		if value, exists := strings.CutPrefix(validation, "choicesFunc="); exists {
+			validations.ChoicesFunc = value
+		}
+
+		// 2.11.4) Case: Excluded values, parsed like the choices.
+		if value, exists := strings.CutPrefix(validation, "exclude="); exists && value != "" {
+			var excluded []any
+			for _, element := range strings.Split(value, syntax.choicesSeparator) {
+				switch validations.Type {
+				case "string", "[]string":
+					excluded = append(excluded, element)
+				case "int", "[]int":
+					if intElement, err := strconv.ParseInt(element, 10, 0); err == nil {
+						excluded = append(excluded, int(intElement))
+					}
+				case "float", "[]float":
+					if floatElement, err := strconv.ParseFloat(element, 0); err == nil {
+						excluded = append(excluded, floatElement)
+					}
+				}
+			}
+			validations.Excluded = excluded
+		}
+
+		// 2.12) Case: Pattern.
+		if value, exists := strings.CutPrefix(validation, "pattern="); exists {
+			if validations.Type == "string" || validations.Type == "[]string" {
+				if validations.Pattern = compilePattern(value); validations.Pattern == nil {
+					validations.invalidPattern = value
+				}
+			}
+		}
+
+		// 2.13) Case: Allow bidi control characters, and reject the emoji and the markup.
+		if value, exists := strings.CutPrefix(validation, "allowBidi="); exists {
+			if validations.Type == "string" || validations.Type == "[]string" {
+				validations.DisallowBidi = value == "false"
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "noEmoji="); exists {
+			if validations.Type == "string" || validations.Type == "[]string" {
+				validations.NoEmoji = value == "true"
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "noMarkup="); exists {
+			if validations.Type == "string" || validations.Type == "[]string" {
+				validations.NoMarkup = value == "true"
+			}
+		}
+
+		// 2.14) Case: Normalizer.
+		if value, exists := strings.CutPrefix(validation, "normalize="); exists {
+			if validations.Type == "string" || validations.Type == "[]string" {
+				validations.Normalize = value
+			}
+		}
+
+		// 2.15) Case: Custom validations and check digits.
+		if value, exists := strings.CutPrefix(validation, "custom="); exists && value != "" {
+			validations.Custom = append(validations.Custom, value)
+		}
+		if value, exists := strings.CutPrefix(validation, "checksum="); exists && value != "" {
+			if validations.Type == "string" || validations.Type == "[]string" {
+				name, weights, _ := strings.Cut(value, syntax.choiceLabelSeparator)
+				validations.Checksum = name
+				if weights != "" {
+					for _, weight := range strings.Split(weights, syntax.choicesSeparator) {
+						if weight, err := strconv.Atoi(weight); err == nil && weight != 0 {
+							validations.ChecksumWeights = append(validations.ChecksumWeights, weight)
+						}
+					}
+				}
+			}
+		}
+
+		// 2.16) Case: Implementations and their discriminator.
+		if value, exists := strings.CutPrefix(validation, "impl="); exists {
+			if validations.Type == "struct" && value != "" {
+				validations.Impl = strings.Split(value, "|")
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "discriminator="); exists {
+			if validations.Type == "struct" {
+				validations.Discriminator = value
+			}
+		}
+
+		// 2.17) Case: Max bytes, of the files (also "maxSize=") and of the decoded data URIs.
+		value, exists := strings.CutPrefix(validation, "maxBytes=")
+		if !exists && validations.Type == "file" {
+			value, exists = strings.CutPrefix(validation, "maxSize=")
+		}
+		if exists {
+			if validations.Type == "file" || validations.Type == "string" {
+				if maxBytes, err := strconv.ParseInt(value, 10, 64); err == nil {
+					validations.MaxBytes = maxBytes
+				}
+			}
+		}
+
+		// 2.18) Case: Request section.
+		if value, exists := strings.CutPrefix(validation, "in="); exists {
+			switch value {
+			case "body", "query", "path", "header":
+				validations.In = value
+			}
+		}
+
+		// 2.19) Case: Allow empty list.
+		if value, exists := strings.CutPrefix(validation, "allowEmptyList="); exists {
+			if strings.HasPrefix(validations.Type, "[]") {
+				validations.DisallowEmptyList = value == "false"
+			}
+		}
+
+		// 2.20) Case: List rule.
+		if value, exists := strings.CutPrefix(validation, "listRule="); exists {
+			if validations.Type == "[]struct" {
+				validations.ListRule = value
+			}
+		}
+
+		// 2.21) Case: Sorted lists, the struct lists by one of their members.
+		if value, exists := strings.CutPrefix(validation, "sorted="); exists && (value == "asc" || value == "desc") {
+			switch validations.Type {
+			case "[]string", "[]int", "[]float", "[]struct":
+				validations.Sorted = value
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "sortedBy="); exists && validations.Type == "[]struct" {
+			validations.SortedBy = value
+		}
+
+		// 2.22) Case: Non-overlapping intervals of the elements, from a start member to an end member.
+		if value, exists := strings.CutPrefix(validation, "nonOverlapping="); exists && validations.Type == "[]struct" {
+			if start, end, found := strings.Cut(value, syntax.choiceLabelSeparator); found && start != "" && end != "" {
+				validations.IntervalStart, validations.IntervalEnd = start, end
+			}
+		}
+
+		// 2.23) Case: Sum and average of a member of the elements.
+		if value, exists := strings.CutPrefix(validation, "sumField="); exists && validations.Type == "[]struct" {
+			validations.SumField = value
+		}
+		if value, exists := strings.CutPrefix(validation, "avgField="); exists && validations.Type == "[]struct" {
+			validations.AvgField = value
+		}
+		if value, exists := strings.CutPrefix(validation, "sumMin="); exists && validations.Type == "[]struct" {
+			if sumMin, err := strconv.ParseFloat(value, 64); err == nil {
+				validations.SumMin = sumMin
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "sumMax="); exists && validations.Type == "[]struct" {
+			if sumMax, err := strconv.ParseFloat(value, 64); err == nil {
+				validations.SumMax = sumMax
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "avgMin="); exists && validations.Type == "[]struct" {
+			if avgMin, err := strconv.ParseFloat(value, 64); err == nil {
+				validations.AvgMin = avgMin
+			}
+		}
+		if value, exists := strings.CutPrefix(validation, "avgMax="); exists && validations.Type == "[]struct" {
+			if avgMax, err := strconv.ParseFloat(value, 64); err == nil {
+				validations.AvgMax = avgMax
+			}
+		}
+
+		// 2.24) Case: Default value, literal or derived from another field.
+		if value, exists := strings.CutPrefix(validation, "default="); exists {
+			switch validations.Type {
+			case "string", "int", "float", "bool":
+				parseDefault(validations, value, syntax)
+			}
+		}
+
+		// 2.24.1) Case: Zero value of the absent optional pointer fields.
+		if value, exists := strings.CutPrefix(validation, "zeroIfAbsent="); exists {
+			validations.ZeroIfAbsent = value == "true"
+		}
+
+		// 2.25) Case: Media types of the data URIs and of the files (also "mimeTypes=").
+		value, exists = strings.CutPrefix(validation, "mediaTypes=")
+		if !exists && validations.Type == "file" {
+			value, exists = strings.CutPrefix(validation, "mimeTypes=")
+		}
+		if exists && value != "" {
+			if validations.Type == "string" || validations.Type == "file" {
+				validations.MediaTypes = strings.Split(value, syntax.choicesSeparator)
+			}
+		}
+
+		// 2.26) Case: Description and example of the field, for the documentation.
+		if value, exists := strings.CutPrefix(validation, "desc="); exists {
+			validations.Description = value
+		}
+		if value, exists := strings.CutPrefix(validation, "example="); exists {
+			validations.Example = value
+		}
+	}
+
+	// 3) Keep the rules of the map itself, the other rules apply to the map values as the rules of their type (e.g.
+	// "min=" is the length of the strings).
+	if valueType, isMap := strings.CutPrefix(validations.Type, "map[string]"); isMap {
+		valueRules := []string{"type=" + valueType}
+		for _, validation := range validationsSplit {
+			switch name, _, _ := strings.Cut(validation, "="); name {
+			case "type", "required", "required_if", "required_unless", "required_with", "minKeys", "maxKeys", "in", "desc", "example":
+			default:
+				valueRules = append(valueRules, validation)
 			}
 		}
+		validations = &Validations{
+			Required:       validations.Required,
+			RequiredIf:     validations.RequiredIf,
+			RequiredUnless: validations.RequiredUnless,
+			RequiredWith:   validations.RequiredWith,
+			Type:           validations.Type,
+			MinKeys:        validations.MinKeys,
+			MaxKeys:        validations.MaxKeys,
+			Values:         parseValidationTags(valueRules, syntax),
+			Scale:          -1,
+			In:             validations.In,
+			Description:    validations.Description,
+			Example:        validations.Example,
+		}
+		validations.Values.structField = "Value"
 	}
 
-	// 3) Return the validations.
+	// 4) Return the validations.
 	return validations
 }
 
-func parseField(validations *Validations, fieldName string, fieldValue any, form reflect.Value, parent string) []error {
+func (s *state) parseField(validations *Validations, fieldName string, fieldNode int, form reflect.Value, parent string) []error {
 	switch validations.Type {
 	case "string":
-		return validateString(validations, fieldName, fieldValue, form, parent)
+		return s.validateString(validations, fieldName, fieldNode, form, parent)
 	case "int":
-		return validateInt(validations, fieldName, fieldValue, form, parent)
+		return s.validateInt(validations, fieldName, fieldNode, form, parent)
 	case "float":
-		return validateFloat(validations, fieldName, fieldValue, form, parent)
+		return s.validateFloat(validations, fieldName, fieldNode, form, parent)
 	case "bool":
-		return validateBool(fieldName, fieldValue, form, parent)
+		return s.validateBool(validations, fieldName, fieldNode, form, parent)
+	case "datetime":
+		return s.validateDatetime(validations, fieldName, fieldNode, form, parent)
+	case "geo":
+		return s.validateGeo(validations, fieldName, fieldNode, form, parent)
+	case "money":
+		return s.validateMoney(validations, fieldName, fieldNode, form, parent)
 	case "struct":
-		return validateStruct(fieldName, fieldValue, form, parent)
+		return s.validateStruct(validations, fieldName, fieldNode, form, parent)
 	case "[]string":
-		return validateList[string](validations, fieldName, fieldValue, form, validateStringType, parent)
+		return validateList[string](s, validations, fieldName, fieldNode, form, validateStringType, parent)
 	case "[]int":
-		return validateList[int](validations, fieldName, fieldValue, form, validateIntType, parent)
+		switch {
+		case validations.intType == nil:
+			return validateList[int](s, validations, fieldName, fieldNode, form, validateIntType, parent)
+		case isUnsigned(validations.intType):
+			return validateList[uint64](s, validations, fieldName, fieldNode, form, sizedUintType(validations.intType.Bits()), parent)
+		default:
+			return validateList[int64](s, validations, fieldName, fieldNode, form, sizedIntType(validations.intType.Bits()), parent)
+		}
 	case "[]float":
-		return validateList[float64](validations, fieldName, fieldValue, form, validateFloatType, parent)
+		return validateList[float64](s, validations, fieldName, fieldNode, form, validateFloatType, parent)
 	case "[]struct":
-		return validateStructList(validations, fieldName, fieldValue, form, parent)
+		return s.validateStructList(validations, fieldName, fieldNode, form, parent)
+	case "map[string]string", "map[string]int", "map[string]float", "map[string]bool", "map[string]struct":
+		return s.validateMap(validations, fieldName, fieldNode, form, parent)
+	case "file":
+		// Files are bound while the multipart body is streamed.
+		return nil
 	default:
 		return nil
 	}
 }
 
-func validateString(validations *Validations, fieldName string, fieldValue any, form reflect.Value, parent string) []error {
+func (s *state) validateString(validations *Validations, fieldName string, fieldNode int, form reflect.Value, parent string) []error {
 
 	// 1) Initialize the errors list.
 	var errors []error
 
-	// 2) Validate fieldValue type.
-	value, invalidFormat := validateStringType(fieldValue)
-	if invalidFormat {
-		errors = append(errors, ValidationError{
-			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], fieldValue),
-		})
+	// 2) Validate fieldNode type.
+	value, invalidFormat := validateStringType(s.document, fieldNode)
+	if invalidFormat || s.strictRejects(validations, fieldNode, "string") {
+		errors = append(errors, s.formatError(getFieldName(parent, fieldName), fieldNode))
 		return errors
 	}
+	s.recordCoercion(getFieldName(parent, fieldName), fieldNode, "string")
 
 	// 3) Validate min and max.
 	if !reflect.ValueOf(validations.Min).IsZero() && len(*value) < int(validations.Min) {
+		s.trigger(getFieldName(parent, fieldName), "min")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidMinString"], int(validations.Min)),
+			Message: s.options.format("InvalidMinString", getFieldName(parent, fieldName), int(validations.Min)),
+			Code:    "min",
 		})
 	}
 	if !reflect.ValueOf(validations.Max).IsZero() && len(*value) > int(validations.Max) {
+		s.trigger(getFieldName(parent, fieldName), "max")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(parent, fieldName),
+			Message: s.options.format("InvalidMaxString", getFieldName(parent, fieldName), int(validations.Max)),
+			Code:    "max",
+		})
+	}
+	if validations.Len != 0 && len(*value) != validations.Len {
+		s.trigger(getFieldName(parent, fieldName), "len")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(parent, fieldName),
+			Message: s.options.format("InvalidLenString", getFieldName(parent, fieldName), validations.Len),
+			Code:    "len",
+		})
+	}
+
+	// 4) Validate the pattern and the format.
+	if err := patternError(validations); err != nil {
+		return []error{err}
+	}
+	if validations.Pattern != nil && !validations.Pattern.MatchString(*value) {
+		s.trigger(getFieldName(parent, fieldName), "pattern")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(parent, fieldName),
+			Message: s.options.format("InvalidPattern", getFieldName(parent, fieldName), validations.Pattern),
+			Code:    "pattern",
+		})
+	}
+	if format, ok := stringFormats[validations.Format]; ok && !format.valid(*value) {
+		s.trigger(getFieldName(parent, fieldName), "format")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(parent, fieldName),
+			Message: s.options.format(format.message, getFieldName(parent, fieldName)),
+			Code:    "format",
+		})
+	} else if validations.Prefixes != nil && !hasPrefix(validations, *value) {
+		s.trigger(getFieldName(parent, fieldName), "prefix")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(parent, fieldName),
+			Message: s.options.format("InvalidPrefix", getFieldName(parent, fieldName), validations.Prefixes),
+			Code:    "prefix",
+		})
+	} else {
+		errors = append(errors, s.validateDigits(validations, getFieldName(parent, fieldName), *value)...)
+		errors = append(errors, s.validateChecksum(validations, getFieldName(parent, fieldName), *value)...)
+	}
+
+	// 5) Validate the bidi control characters, the emoji and the markup.
+	if validations.DisallowBidi && hasBidiControl(*value) {
+		s.trigger(getFieldName(parent, fieldName), "allowBidi")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(parent, fieldName),
+			Message: s.options.format("InvalidBidi", getFieldName(parent, fieldName)),
+			Code:    "bidi",
+		})
+	}
+	if validations.NoEmoji && hasEmoji(*value) {
+		s.trigger(getFieldName(parent, fieldName), "noEmoji")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(parent, fieldName),
+			Message: s.options.format("InvalidEmoji", getFieldName(parent, fieldName)),
+			Code:    "emoji",
+		})
+	}
+	if validations.NoMarkup && hasMarkup(*value) {
+		s.trigger(getFieldName(parent, fieldName), "noMarkup")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidMaxString"], int(validations.Max)),
+			Message: s.options.format("InvalidMarkup", getFieldName(parent, fieldName)),
+			Code:    "markup",
 		})
 	}
 
-	// 4) Validate choices.
+	// 6) Validate choices, the ones of a provider resolved now.
+	validations, err := resolveChoices(validations)
+	if err != nil {
+		return append(errors, err)
+	}
 	if !reflect.ValueOf(validations.Choices).IsZero() && !contains[string](validations.Choices, *value) {
+		s.trigger(getFieldName(parent, fieldName), "choices")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], *value, validations.Choices),
+			Message: s.choiceMessage(getFieldName(parent, fieldName), *value, validations),
+			Code:    "choice",
 		})
 	}
+	if validations.Excluded != nil && contains[string](validations.Excluded, *value) {
+		errors = append(errors, s.excludedError(getFieldName(parent, fieldName), *value))
+	}
+	if errors != nil {
+		return errors
+	}
+
+	// 7) Normalize the received value.
+	normalized, errors := s.normalize(validations, *value, getFieldName(parent, fieldName))
 	if errors != nil {
 		return errors
 	}
 
-	// 5) Update form with the received value.
-	form.FieldByName(TitleCase(fieldName)).Set(reflect.ValueOf(value))
+	// 8) Validate the custom rules.
+	if customErrors := s.validateCustom(validations, getFieldName(parent, fieldName), normalized); customErrors != nil {
+		return customErrors
+	}
 
-	// 6) Return errors.
+	// 9) Update form with the received value, or with the terms of the expressions.
+	switch validations.Format {
+	case "sortexpr":
+		return s.validateSortExpression(validations, getFieldName(parent, fieldName), normalized, form)
+	case "filterexpr":
+		return s.validateFilterExpression(validations, getFieldName(parent, fieldName), normalized, form)
+	case "datauri":
+		return s.validateDataURI(validations, getFieldName(parent, fieldName), normalized, form)
+	}
+	setValue(form, validations, &normalized)
+
+	// 10) Return errors.
 	return errors
 }
 
-func validateStringType(fieldValue any) (*string, bool) {
+func validateStringType(d *document, i int) (*string, bool) {
 
 	// 1) Initialize variables.
 	var invalidFormat = true
 	var value string
 
-	// 2) Validate fieldValue type.
-	switch v := fieldValue.(type) {
-	case string:
-		value = v
+	// 2) Validate the node type.
+	switch d.kind(i) {
+	case kindString:
+		value = d.stringValue(i)
+		invalidFormat = false
+	case kindNumber:
+		value = fmt.Sprintf("%v", d.numberValue(i))
 		invalidFormat = false
-	case float64, int, bool:
-		value = fmt.Sprintf("%v", v)
+	case kindBool:
+		value = fmt.Sprintf("%v", d.boolValue(i))
 		invalidFormat = false
 	}
 
@@ -207,148 +758,286 @@ func validateStringType(fieldValue any) (*string, bool) {
 	return &value, invalidFormat
 }
 
-func validateInt(validations *Validations, fieldName string, fieldValue any, form reflect.Value, parent string) []error {
+func (s *state) validateInt(validations *Validations, fieldName string, fieldNode int, form reflect.Value, parent string) []error {
+	switch {
+	case validations.intType == nil:
+		return validateInteger[int](s, validations, fieldName, fieldNode, form, validateIntType, parent)
+	case isUnsigned(validations.intType):
+		return validateInteger[uint64](s, validations, fieldName, fieldNode, form, sizedUintType(validations.intType.Bits()), parent)
+	default:
+		return validateInteger[int64](s, validations, fieldName, fieldNode, form, sizedIntType(validations.intType.Bits()), parent)
+	}
+}
+
+// validateInteger validates an integer field, parsed as an int, or as an int64 or an uint64 within the bits of the
+// sized integer type of the field.
+func validateInteger[T int | int64 | uint64](s *state, validations *Validations, fieldName string, fieldNode int, form reflect.Value, validateElement func(*document, int) (*T, bool), parent string) []error {
 
 	// 1) Initialize the errors list.
 	var errors []error
 
-	// 2) Validate the fieldValue type.
-	value, invalidFormat := validateIntType(fieldValue)
-	if invalidFormat {
-		errors = append(errors, ValidationError{
-			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], fieldValue),
-		})
+	// 2) Validate the fieldNode type.
+	value, invalidFormat := validateElement(s.document, fieldNode)
+	if invalidFormat || s.strictRejects(validations, fieldNode, "int") {
+		if !s.strictRejects(validations, fieldNode, "int") && s.intOutOfRange(fieldNode, validations.intType) {
+			return append(errors, s.outOfRangeError(getFieldName(parent, fieldName), fieldNode, validations.intType))
+		}
+		errors = append(errors, s.formatError(getFieldName(parent, fieldName), fieldNode))
 		return errors
 	}
+	s.recordCoercion(getFieldName(parent, fieldName), fieldNode, "int")
 
-	// 3) Validate min and max.
-	if !reflect.ValueOf(validations.Min).IsZero() && *value < int(validations.Min) {
+	// 3) Validate min and max, truncated to integers.
+	if !reflect.ValueOf(validations.Min).IsZero() && float64(*value) < math.Trunc(validations.Min) {
+		s.trigger(getFieldName(parent, fieldName), "min")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidMinNumber"], int(validations.Min)),
+			Message: s.options.format("InvalidMinNumber", getFieldName(parent, fieldName), int(validations.Min)),
+			Code:    "min",
 		})
 	}
-	if !reflect.ValueOf(validations.Max).IsZero() && *value > int(validations.Max) {
+	if !reflect.ValueOf(validations.Max).IsZero() && float64(*value) > math.Trunc(validations.Max) {
+		s.trigger(getFieldName(parent, fieldName), "max")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidMaxNumber"], int(validations.Max)),
+			Message: s.options.format("InvalidMaxNumber", getFieldName(parent, fieldName), int(validations.Max)),
+			Code:    "max",
 		})
 	}
 
-	// 4) Validate choices.
-	if !reflect.ValueOf(validations.Choices).IsZero() && !contains[int](validations.Choices, *value) {
+	// 4) Validate choices, the ones of a provider resolved now.
+	validations, err := resolveChoices(validations)
+	if err != nil {
+		return append(errors, err)
+	}
+	if !reflect.ValueOf(validations.Choices).IsZero() && !contains[T](validations.Choices, *value) {
+		s.trigger(getFieldName(parent, fieldName), "choices")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], *value, validations.Choices),
+			Message: s.choiceMessage(getFieldName(parent, fieldName), *value, validations),
+			Code:    "choice",
 		})
 	}
+	if validations.Excluded != nil && contains[T](validations.Excluded, *value) {
+		errors = append(errors, s.excludedError(getFieldName(parent, fieldName), *value))
+	}
 	if errors != nil {
 		return errors
 	}
 
-	// 5) Update form with the received value.
-	form.FieldByName(TitleCase(fieldName)).Set(reflect.ValueOf(value))
+	// 5) Validate the custom rules.
+	if customErrors := s.validateCustom(validations, getFieldName(parent, fieldName), *value); customErrors != nil {
+		return customErrors
+	}
 
-	// 6) Return errors.
+	// 6) Update form with the received value, converted to the sized integer of the field.
+	if validations.intType != nil {
+		setField(formField(form, validations), reflect.ValueOf(*value).Convert(validations.intType))
+	} else {
+		setValue(form, validations, value)
+	}
+
+	// 7) Return errors.
 	return errors
 }
 
-func validateIntType(fieldValue any) (*int, bool) {
+// isSizedInt reports whether the type is an integer type other than int (e.g. int32, int64 or uint).
+func isSizedInt(intType reflect.Type) bool {
+	switch intType.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// isUnsigned reports whether the integer type is unsigned.
+func isUnsigned(intType reflect.Type) bool {
+	switch intType.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// sizedIntType returns the parsing function of the integers bound into a signed sized integer type of the bits, which
+// rejects the values that overflow it (e.g. 3e10 into an int32, see intOutOfRange).
+func sizedIntType(bits int) func(*document, int) (*int64, bool) {
+	return func(d *document, i int) (*int64, bool) {
+		var value int64
+		raw, ok := integerText(d, i)
+		if !ok {
+			return &value, true
+		}
+		parsed, err := strconv.ParseInt(raw, 10, bits)
+		if err != nil {
+			return &value, true
+		}
+		value = parsed
+		return &value, false
+	}
+}
+
+// sizedUintType returns the parsing function of the integers bound into an unsigned sized integer type of the bits,
+// which rejects the values that overflow it (e.g. -1 or 18446744073709551616 into an uint64, see intOutOfRange).
+func sizedUintType(bits int) func(*document, int) (*uint64, bool) {
+	return func(d *document, i int) (*uint64, bool) {
+		var value uint64
+		raw, ok := integerText(d, i)
+		if !ok {
+			return &value, true
+		}
+		parsed, err := strconv.ParseUint(strings.TrimPrefix(raw, "+"), 10, bits)
+		if err != nil {
+			return &value, true
+		}
+		value = parsed
+		return &value, false
+	}
+}
+
+// integerText returns the decimal digits of the integer held by the node: the numeric strings as they are, and the
+// json numbers written as integers (e.g. 3e10 or 2.0) without their exponent or their zero decimals.
+func integerText(d *document, i int) (string, bool) {
+	switch d.kind(i) {
+	case kindString:
+		return d.stringValue(i), true
+	case kindNumber:
+		raw := string(d.raw(i))
+		if !strings.ContainsAny(raw, ".eE") {
+			return raw, true
+		}
+		number, ok := new(big.Float).SetPrec(256).SetString(raw)
+		if !ok || number.IsInf() || !number.IsInt() {
+			return "", false
+		}
+		integer, _ := number.Int(nil)
+		return integer.String(), true
+	}
+	return "", false
+}
+
+func validateIntType(d *document, i int) (*int, bool) {
 
 	// 1) Initialize variables.
 	var invalidFormat = true
 	var value int
 
-	// 2) Validate fieldValue type.
-	switch v := fieldValue.(type) {
-	case string:
-		intValue, err := strconv.ParseInt(v, 10, 0)
+	// 2) Validate the node type.
+	switch d.kind(i) {
+	case kindString:
+		intValue, err := strconv.ParseInt(d.stringValue(i), 10, 0)
 		if err == nil {
 			invalidFormat = false
 			value = int(intValue)
 		}
-	case float64:
+	case kindNumber:
+
+		// 2.1) The integers are parsed from their text, so the ones above 2^53 keep their precision, the other numbers
+		// (e.g. 3e10) through a float.
+		intValue, err := strconv.ParseInt(string(d.raw(i)), 10, 0)
+		if err == nil {
+			value = int(intValue)
+			invalidFormat = false
+			break
+		}
+		if errors.Is(err, strconv.ErrRange) {
+			break
+		}
+		v := d.numberValue(i)
 		castedValue := int(v)
 		if float64(castedValue) == v {
 			value = castedValue
 			invalidFormat = false
 		}
-	case int:
-		value = v
-		invalidFormat = false
 	}
 
 	// 3) Return.
 	return &value, invalidFormat
 }
 
-func validateFloat(validations *Validations, fieldName string, fieldValue any, form reflect.Value, parent string) []error {
+func (s *state) validateFloat(validations *Validations, fieldName string, fieldNode int, form reflect.Value, parent string) []error {
 
 	// 1) Initialize the errors list.
 	var errors []error
 
-	// 2) Validate the fieldValue type.
-	value, invalidFormat := validateFloatType(fieldValue)
-	if invalidFormat {
-		errors = append(errors, ValidationError{
-			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], fieldValue),
-		})
+	// 2) Validate the fieldNode type.
+	value, invalidFormat := validateFloatType(s.document, fieldNode)
+	if invalidFormat || s.strictRejects(validations, fieldNode, "float") {
+		errors = append(errors, s.formatError(getFieldName(parent, fieldName), fieldNode))
 		return errors
 	}
-
-	// 3) Validate min and max.
-	if !reflect.ValueOf(validations.Min).IsZero() && *value < validations.Min {
+	if errors = s.nonFiniteErrors(getFieldName(parent, fieldName), fieldNode, *value); errors != nil {
+		return errors
+	}
+	s.recordCoercion(getFieldName(parent, fieldName), fieldNode, "float")
+
+	// 3) Validate min and max, within the epsilon.
+	if !reflect.ValueOf(validations.Min).IsZero() && *value < validations.Min-validations.Epsilon {
+		s.trigger(getFieldName(parent, fieldName), "min")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidMinNumber"], validations.Min),
+			Message: s.options.format("InvalidMinNumber", getFieldName(parent, fieldName), validations.Min),
+			Code:    "min",
 		})
 	}
-	if !reflect.ValueOf(validations.Max).IsZero() && *value > validations.Max {
+	if !reflect.ValueOf(validations.Max).IsZero() && *value > validations.Max+validations.Epsilon {
+		s.trigger(getFieldName(parent, fieldName), "max")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidMaxNumber"], validations.Max),
+			Message: s.options.format("InvalidMaxNumber", getFieldName(parent, fieldName), validations.Max),
+			Code:    "max",
 		})
 	}
 
-	// 4) Validate choices.
-	if !reflect.ValueOf(validations.Choices).IsZero() && !contains[float64](validations.Choices, *value) {
+	// 4) Validate choices, within the epsilon, the ones of a provider resolved now.
+	validations, err := resolveChoices(validations)
+	if err != nil {
+		return append(errors, err)
+	}
+	if !reflect.ValueOf(validations.Choices).IsZero() && !containsChoice[float64](validations, validations.Choices, *value) {
+		s.trigger(getFieldName(parent, fieldName), "choices")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], *value, validations.Choices),
+			Message: s.choiceMessage(getFieldName(parent, fieldName), *value, validations),
+			Code:    "choice",
 		})
 	}
+	if validations.Excluded != nil && containsChoice[float64](validations, validations.Excluded, *value) {
+		errors = append(errors, s.excludedError(getFieldName(parent, fieldName), *value))
+	}
 	if errors != nil {
 		return errors
 	}
 
-	// 5) Update form with the received value.
-	form.FieldByName(TitleCase(fieldName)).Set(reflect.ValueOf(value))
+	// 5) Validate the custom rules.
+	if customErrors := s.validateCustom(validations, getFieldName(parent, fieldName), *value); customErrors != nil {
+		return customErrors
+	}
+
+	// 6) Update form with the received value.
+	setValue(form, validations, value)
 
-	// 6) Return errors.
+	// 7) Return errors.
 	return errors
 }
 
-func validateFloatType(fieldValue any) (*float64, bool) {
+func validateFloatType(d *document, i int) (*float64, bool) {
 
 	// 1) Initialize variables.
 	var invalidFormat = true
 	var value float64
 
-	// 2) Validate fieldValue type.
-	switch v := fieldValue.(type) {
-	case string:
-		valueParsed, err := strconv.ParseFloat(v, 0)
+	// 2) Validate the node type.
+	switch d.kind(i) {
+	case kindString:
+		valueParsed, err := strconv.ParseFloat(d.stringValue(i), 0)
 		if err == nil {
 			value = valueParsed
 			invalidFormat = false
 		}
-	case float64:
-		value = v
-		invalidFormat = false
-	case int:
-		value = float64(v)
+	case kindNumber:
+		value = d.numberValue(i)
 		invalidFormat = false
 	}
 
@@ -356,45 +1045,48 @@ func validateFloatType(fieldValue any) (*float64, bool) {
 	return &value, invalidFormat
 }
 
-func validateBool(fieldName string, fieldValue any, form reflect.Value, parent string) []error {
+func (s *state) validateBool(validations *Validations, fieldName string, fieldNode int, form reflect.Value, parent string) []error {
 
 	// 1) Initialize the errors list.
 	var errors []error
 
-	// 2) Validate the fieldValue type.
-	value, invalidFormat := validateBoolType(fieldValue)
-	if invalidFormat {
-		errors = append(errors, ValidationError{
-			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], fieldValue),
-		})
+	// 2) Validate the fieldNode type.
+	value, invalidFormat := validateBoolType(s.document, fieldNode)
+	if invalidFormat || s.strictRejects(validations, fieldNode, "bool") {
+		errors = append(errors, s.formatError(getFieldName(parent, fieldName), fieldNode))
 		return errors
 	}
+	s.recordCoercion(getFieldName(parent, fieldName), fieldNode, "bool")
 
-	// 3) Update form with the received value.
-	form.FieldByName(TitleCase(fieldName)).Set(reflect.ValueOf(value))
+	// 3) Validate the custom rules.
+	if customErrors := s.validateCustom(validations, getFieldName(parent, fieldName), *value); customErrors != nil {
+		return customErrors
+	}
 
-	// 4) Return errors.
+	// 4) Update form with the received value.
+	setValue(form, validations, value)
+
+	// 5) Return errors.
 	return nil
 }
 
-func validateBoolType(fieldValue any) (*bool, bool) {
+func validateBoolType(d *document, i int) (*bool, bool) {
 
 	// 1) Initialize variables.
 	var invalidFormat = true
 	var value bool
 
-	// 2) Validate fieldValue type.
-	switch v := fieldValue.(type) {
-	case string, int, float64:
-		parsed := fmt.Sprintf("%v", v)
+	// 2) Validate the node type.
+	switch d.kind(i) {
+	case kindString, kindNumber:
+		parsed := fmt.Sprintf("%v", d.value(i))
 		boolValue, err := strconv.ParseBool(parsed)
 		if err == nil {
 			value = boolValue
 			invalidFormat = false
 		}
-	case bool:
-		value = v
+	case kindBool:
+		value = d.boolValue(i)
 		invalidFormat = false
 	}
 
@@ -402,138 +1094,350 @@ func validateBoolType(fieldValue any) (*bool, bool) {
 	return &value, invalidFormat
 }
 
-func validateStruct(fieldName string, fieldValue any, form reflect.Value, parent string) []error {
+func (s *state) validateDatetime(validations *Validations, fieldName string, fieldNode int, form reflect.Value, parent string) []error {
 
-	// 1) Get field from the form and the inner struct.
-	field := form.FieldByName(TitleCase(fieldName))
+	// 1) Get the layout of the field.
+	layout := validations.Format
+	if layout == "" {
+		layout = time.RFC3339
+	}
 
-	// 2) Instantiate the field with the respecting type.
+	// 2) Validate the fieldNode type and parse the datetime.
+	if s.document.kind(fieldNode) != kindString {
+		return []error{s.formatError(getFieldName(parent, fieldName), fieldNode)}
+	}
+	value, err := time.Parse(layout, s.document.stringValue(fieldNode))
+	if err != nil {
+		validationError := s.formatError(getFieldName(parent, fieldName), fieldNode)
+		validationError.Message = s.options.format("InvalidDatetime", getFieldName(parent, fieldName), s.document.stringValue(fieldNode), layout)
+		validationError.Code = "format"
+		return []error{validationError}
+	}
+
+	// 3) Validate the custom rules.
+	if customErrors := s.validateCustom(validations, getFieldName(parent, fieldName), value); customErrors != nil {
+		return customErrors
+	}
+
+	// 4) Update form with the parsed value.
+	setValue(form, validations, &value)
+
+	// 5) Return errors.
+	return nil
+}
+
+func (s *state) validateStruct(validations *Validations, fieldName string, fieldNode int, form reflect.Value, parent string) []error {
+
+	// 1) Validate the fieldNode type.
+	if kind := s.document.kind(fieldNode); kind != kindObject && kind != kindNull {
+		return []error{s.formatError(getFieldName(parent, fieldName), fieldNode)}
+	}
+
+	// 2) Get field from the form and instantiate the inner struct with the respecting type.
+	field := formField(form, validations)
+	if field.Kind() == reflect.Interface {
+		return s.validateImplementation(validations, fieldName, fieldNode, field, parent)
+	}
 	field.Set(reflect.New(field.Type().Elem()))
 	field = field.Elem()
 
-	// 2) Parse the value to []byte.
-	jsonData, _ := json.Marshal(fieldValue)
-
 	// 3) Get validations map.
-	validationsMap := getValidations(field)
+	validationsMap := getValidations(field, s.options.syntax())
 
-	errors := validateJsonData(jsonData, field, validationsMap, getFieldName(parent, fieldName))
+	// 4) Validate the inner object.
+	errors := s.validateObject(fieldNode, field, validationsMap, getFieldName(parent, fieldName))
 
-	// 4) Return errors.
+	// 5) Return errors.
 	return errors
 }
 
-func validateList[T string | int | float64](validations *Validations, fieldName string, fieldValue any, form reflect.Value, validateElement func(any) (*T, bool), parent string) []error {
+// validateImplementation validates an interface field against the concrete type named by the discriminator of the
+// object, and binds it into the field.
+func (s *state) validateImplementation(validations *Validations, fieldName string, fieldNode int, field reflect.Value, parent string) []error {
+
+	// 1) Null objects leave the field empty.
+	if s.document.kind(fieldNode) == kindNull {
+		return nil
+	}
+
+	// 2) Get the concrete type named by the discriminator.
+	discriminator := validations.Discriminator
+	if discriminator == "" {
+		discriminator = DefaultDiscriminator
+	}
+	discriminatorField := getFieldName(getFieldName(parent, fieldName), discriminator)
+	discriminatorNode := s.document.member(fieldNode, discriminator)
+	if discriminatorNode < 0 {
+		s.trigger(getFieldName(parent, fieldName), "impl")
+		return []error{ValidationError{
+			Field:   discriminatorField,
+			Message: s.options.format("RequiredField", discriminatorField),
+			Code:    "required",
+		}}
+	}
+	name := fmt.Sprintf("%v", s.document.value(discriminatorNode))
+	implType, ok := Implementations[name]
+	if !ok || !containsAny(toAny(validations.Impl), name) {
+		s.trigger(getFieldName(parent, fieldName), "impl")
+		return []error{ValidationError{
+			Field:   discriminatorField,
+			Message: s.choiceMessage(discriminatorField, name, &Validations{Choices: toAny(validations.Impl)}),
+			Code:    "choice",
+		}}
+	}
+
+	// 3) Validate the object against the concrete type.
+	impl := reflect.New(implType)
+	errors := s.validateObject(fieldNode, impl.Elem(), getValidations(impl.Elem(), s.options.syntax()), getFieldName(parent, fieldName))
+	if errors != nil {
+		return errors
+	}
+
+	// 4) Bind the concrete value (or its pointer, when only the pointer implements the interface) into the field.
+	switch {
+	case implType.AssignableTo(field.Type()):
+		field.Set(impl.Elem())
+	case impl.Type().AssignableTo(field.Type()):
+		field.Set(impl)
+	}
+
+	// 5) Return errors.
+	return nil
+}
+
+func toAny(values []string) []any {
+	result := make([]any, len(values))
+	for i, value := range values {
+		result[i] = value
+	}
+	return result
+}
+
+func validateList[T string | int | int64 | uint64 | float64](s *state, validations *Validations, fieldName string, fieldNode int, form reflect.Value, validateElement func(*document, int) (*T, bool), parent string) []error {
 
 	// 1) Initialize an errors list.
 	var errors []error
 
-	// 2) Validate fieldValue type.
-	value, ok := fieldValue.([]any)
-	if !ok {
+	// 2) Validate fieldNode type.
+	if s.document.kind(fieldNode) != kindArray {
+		return append(errors, s.formatError(getFieldName(parent, fieldName), fieldNode))
+	}
+	value := s.document.elements(fieldNode)
+
+	// 3) Validate the empty list, min and max.
+	if validations.DisallowEmptyList && len(value) == 0 {
+		s.trigger(getFieldName(parent, fieldName), "allowEmptyList")
 		return append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], fieldValue),
+			Message: s.options.format("EmptyList", getFieldName(parent, fieldName)),
+			Code:    "empty_list",
 		})
 	}
-
-	// 3) Validate min and max.
 	if !reflect.ValueOf(validations.Min).IsZero() && len(value) < int(validations.Min) {
+		s.trigger(getFieldName(parent, fieldName), "min")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidMinList"], int(validations.Min)),
+			Message: s.options.format("InvalidMinList", getFieldName(parent, fieldName), int(validations.Min)),
+			Code:    "min",
 		})
 	}
 	if !reflect.ValueOf(validations.Max).IsZero() && len(value) > int(validations.Max) {
+		s.trigger(getFieldName(parent, fieldName), "max")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidMaxList"], int(validations.Max)),
+			Message: s.options.format("InvalidMaxList", getFieldName(parent, fieldName), int(validations.Max)),
+			Code:    "max",
+		})
+	}
+	if validations.Len != 0 && len(value) != validations.Len {
+		s.trigger(getFieldName(parent, fieldName), "len")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(parent, fieldName),
+			Message: s.options.format("InvalidLenList", getFieldName(parent, fieldName), validations.Len),
+			Code:    "len",
 		})
 	}
 	if errors != nil {
 		return errors
 	}
 
-	// 4) Parse elements.
-	parsedValues, errors := parseElements[T](value, validateElement, getFieldName(parent, fieldName))
+	// 4) Parse elements and validate their order.
+	parsedValues, errors := parseElements[T](s, validations, value, validateElement, getFieldName(parent, fieldName))
 	if errors != nil {
 		return errors
 	}
+	if errors = validateSorted[T](s, validations, parsedValues, getFieldName(parent, fieldName)); errors != nil {
+		return errors
+	}
 
-	// 5) Remove duplicate.
-	parsedValues = removeDuplicate[T](parsedValues)
+	// 5) Validate the pattern, the format, the bidi control characters and the choices, on the elements as they are
+	// indexed in the payload.
+	errors = append(validateListPattern[T](s, validations, parsedValues, getFieldName(parent, fieldName)),
+		validateListChoices[T](s, validations, parsedValues, getFieldName(parent, fieldName))...)
+	if errors != nil {
+		return errors
+	}
 
-	// 6) Validate choices.
-	errors = validateListChoices[T](validations.Choices, parsedValues, getFieldName(parent, fieldName))
+	// 6) Normalize the values.
+	parsedValues, errors = normalizeList[T](s, validations, parsedValues, getFieldName(parent, fieldName))
 	if errors != nil {
 		return errors
 	}
 
-	// 7) Update the form with the parsed values.
-	form.FieldByName(TitleCase(fieldName)).Set(reflect.ValueOf(parsedValues))
+	// 7) Validate the custom rules of the values.
+	if errors = validateListCustom[T](s, validations, parsedValues, getFieldName(parent, fieldName)); errors != nil {
+		return errors
+	}
 
-	// 8) Return errors.
+	// 8) Remove duplicate.
+	parsedValues = removeDuplicate[T](parsedValues)
+
+	// 9) Update the form with the parsed values, converted to the sized integers of the field.
+	values := reflect.ValueOf(parsedValues)
+	if validations.intType != nil {
+		converted := reflect.MakeSlice(reflect.SliceOf(validations.intType), values.Len(), values.Len())
+		for i := 0; i < values.Len(); i++ {
+			converted.Index(i).Set(values.Index(i).Convert(validations.intType))
+		}
+		values = converted
+	}
+	setField(formField(form, validations), values)
+
+	// 10) Return errors.
 	return nil
 }
 
-func validateStructList(validations *Validations, fieldName string, fieldValue any, form reflect.Value, parent string) []error {
+func (s *state) validateStructList(validations *Validations, fieldName string, fieldNode int, form reflect.Value, parent string) []error {
 
 	// 1) Initialize an errors list.
 	var errors []error
 
-	// 2) Validate fieldValue type.
-	valueList, ok := fieldValue.([]any)
-	if !ok {
+	// 2) Validate fieldNode type.
+	if s.document.kind(fieldNode) != kindArray {
+		return append(errors, s.formatError(getFieldName(parent, fieldName), fieldNode))
+	}
+	valueList := s.document.elements(fieldNode)
+
+	// 3) Validate the empty list, min and max.
+	if validations.DisallowEmptyList && len(valueList) == 0 {
+		s.trigger(getFieldName(parent, fieldName), "allowEmptyList")
 		return append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], fieldValue),
+			Message: s.options.format("EmptyList", getFieldName(parent, fieldName)),
+			Code:    "empty_list",
 		})
 	}
-
-	// 3) Validate min and max.
 	if !reflect.ValueOf(validations.Min).IsZero() && len(valueList) < int(validations.Min) {
+		s.trigger(getFieldName(parent, fieldName), "min")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidMinList"], int(validations.Min)),
+			Message: s.options.format("InvalidMinList", getFieldName(parent, fieldName), int(validations.Min)),
+			Code:    "min",
 		})
 	}
 	if !reflect.ValueOf(validations.Max).IsZero() && len(valueList) > int(validations.Max) {
+		s.trigger(getFieldName(parent, fieldName), "max")
 		errors = append(errors, ValidationError{
 			Field:   getFieldName(parent, fieldName),
-			Message: fmt.Sprintf(DefaultMessages["InvalidMaxList"], int(validations.Max)),
+			Message: s.options.format("InvalidMaxList", getFieldName(parent, fieldName), int(validations.Max)),
+			Code:    "max",
+		})
+	}
+	if validations.Len != 0 && len(valueList) != validations.Len {
+		s.trigger(getFieldName(parent, fieldName), "len")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(parent, fieldName),
+			Message: s.options.format("InvalidLenList", getFieldName(parent, fieldName), validations.Len),
+			Code:    "len",
 		})
 	}
 	if errors != nil {
 		return errors
 	}
 
-	// 4) Parse struct elements.
-	field := form.FieldByName(TitleCase(fieldName))
-	errs := parseStructElements(field, valueList, getFieldName(parent, fieldName))
-	errors = append(errors, errs...)
+	// 4) Parse struct elements, into a new slice for the pointer fields (e.g. *[]Person).
+	field := formField(form, validations)
+	list := field
+	if field.Kind() == reflect.Pointer {
+		list = reflect.New(field.Type().Elem()).Elem()
+	}
+	errs := s.parseStructElements(list, valueList, getFieldName(parent, fieldName))
+	if errs != nil {
+		return errs
+	}
+	setField(field, list)
 
-	// 5) Return errors.
+	// 5) Validate the list rule against all the bound elements, the order, the overlaps, and the sum and the average of
+	// their members.
+	errors = validateListRule(validations.ListRule, list, getFieldName(parent, fieldName))
+	if _, ok := ListRules[validations.ListRule]; !ok && errors != nil {
+		return errors
+	}
+	if errors != nil {
+		s.trigger(getFieldName(parent, fieldName), "listRule")
+	}
+	errors = append(errors, s.validateSortedBy(validations, valueList, getFieldName(parent, fieldName))...)
+	errors = append(errors, s.validateNonOverlapping(validations, valueList, getFieldName(parent, fieldName))...)
+	errors = append(errors, s.validateAggregates(validations, fieldName, valueList, parent)...)
+
+	// 6) Return errors.
 	return errors
 }
 
-func parseElements[T string | int | float64](valuesList []any, validateElement func(any) (*T, bool), parent string) ([]T, []error) {
+func validateListRule(ruleName string, field reflect.Value, parent string) []error {
+
+	// 1) Initialize an errors list.
+	var errors []error
+
+	// 2) Get the registered rule, if any, a rule that is not registered is a ConfigError.
+	if ruleName == "" {
+		return nil
+	}
+	rule, ok := ListRules[ruleName]
+	if !ok {
+		return []error{ConfigError{Message: fmt.Sprintf("no list rule is registered as %s", ruleName)}}
+	}
+
+	// 3) Run the rule and convert the messages into errors.
+	for i, message := range rule(field.Interface()) {
+		fieldName := parent
+		if i >= 0 {
+			fieldName = parent + "[" + strconv.Itoa(i) + "]"
+		}
+		errors = append(errors, ValidationError{
+			Field:   fieldName,
+			Message: message,
+			Code:    ruleName,
+		})
+	}
+
+	// 4) Return the errors.
+	return errors
+}
+
+func parseElements[T string | int | int64 | uint64 | float64](s *state, validations *Validations, valuesList []int, validateElement func(*document, int) (*T, bool), parent string) ([]T, []error) {
 
 	// 1) Initialize errors list and values parsed list.
 	var errors []error
 	var parsedValues []T
+	elementType := strings.TrimPrefix(validations.Type, "[]")
 
 	// 2) Iterate over the values list received.
 	for i, element := range valuesList {
 
 		// 2.1) Validate the element.
-		elemValue, invalidFormat := validateElement(element)
-
-		// 2.2) If the element has an invalid format, add the error to the errors list.
-		if invalidFormat {
-			errors = append(errors, ValidationError{
-				Field:   parent + "[" + strconv.Itoa(i) + "]",
-				Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], element),
-			})
+		elemValue, invalidFormat := validateElement(s.document, element)
+
+		// 2.2) If the element has an invalid format, does not fit the integer type or is not a finite float, add the error
+		// to the errors list.
+		if invalidFormat && elementType == "int" && !s.strictRejects(validations, element, elementType) && s.intOutOfRange(element, validations.intType) {
+			errors = append(errors, s.outOfRangeError(parent+"["+strconv.Itoa(i)+"]", element, validations.intType))
+		} else if invalidFormat || s.strictRejects(validations, element, elementType) {
+			errors = append(errors, s.formatError(parent+"["+strconv.Itoa(i)+"]", element))
+		} else if nonFiniteErrors := s.nonFiniteErrors(parent+"["+strconv.Itoa(i)+"]", element, any(*elemValue)); nonFiniteErrors != nil {
+			errors = append(errors, nonFiniteErrors...)
+		} else {
+			s.recordCoercion(parent+"["+strconv.Itoa(i)+"]", element, elementType)
 		}
 
 		// 2.3) Add the value to the values parsed list.
@@ -544,29 +1448,38 @@ func parseElements[T string | int | float64](valuesList []any, validateElement f
 	return parsedValues, errors
 }
 
-func parseStructElements(field reflect.Value, valueList []any, parent string) []error {
+func (s *state) parseStructElements(field reflect.Value, valueList []int, parent string) []error {
 
 	// 1) Initialize an errors list.
 	var errors []error
 
-	// 2) Make the slice cap and len the same as the size of the valueList.
+	// 2) Make the slice cap and len the same as the size of the valueList, and get the validations of the elements once.
 	field.Grow(len(valueList))
 	sliceField := field.Slice(0, len(valueList))
+	validationsMap := getValidations(reflect.New(structType(field.Type())).Elem(), s.options.syntax())
 
 	// 3) Iterate over the value list to validate and parse each element.
 	for i, value := range valueList {
 
-		// 3.1) Get the element by the index and initialise the inner struct pointer.
-		element := sliceField.Index(i)
-
-		// 3.2) Marshal the value to json.
-		jsonData, _ := json.Marshal(value)
+		// 3.1) Validate the value type.
+		if kind := s.document.kind(value); kind != kindObject && kind != kindNull {
+			errors = append(errors, s.formatError(parent+"["+strconv.Itoa(i)+"]", value))
+			continue
+		}
 
-		// 3.3) Get the validation for the given element.
-		validationsMap := getValidations(element)
+		// 3.2) Get the element by the index, allocating the pointer elements (e.g. []*Person), which are left nil by null.
+		element := sliceField.Index(i)
+		if element.Kind() == reflect.Pointer {
+			if s.document.kind(value) == kindNull {
+				element.Set(reflect.Zero(element.Type()))
+				continue
+			}
+			element.Set(reflect.New(element.Type().Elem()))
+			element = element.Elem()
+		}
 
-		// 3.4) Validate the json data.
-		errs := validateJsonData(jsonData, element, validationsMap, parent+"["+strconv.Itoa(i)+"]")
+		// 3.3) Validate the inner object.
+		errs := s.validateObject(value, element, validationsMap, parent+"["+strconv.Itoa(i)+"]")
 		errors = append(errors, errs...)
 	}
 
@@ -579,28 +1492,268 @@ func parseStructElements(field reflect.Value, valueList []any, parent string) []
 	return errors
 }
 
-func validateListChoices[T string | int | float64](choices []any, parsedValues []T, parent string) []error {
+func validateListChoices[T string | int | int64 | uint64 | float64](s *state, validations *Validations, parsedValues []T, parent string) []error {
 
 	// 1) Initialize an errors list.
 	var errors []error
 
-	// 2) If we have received choices, validate them.
-	if !reflect.ValueOf(choices).IsZero() {
+	// 2) If we have received choices (or a provider of choices), validate them.
+	validations, err := resolveChoices(validations)
+	if err != nil {
+		return []error{err}
+	}
+	if !reflect.ValueOf(validations.Choices).IsZero() {
 		for i, element := range parsedValues {
-			if !contains[T](choices, element) {
+			if !containsChoice[T](validations, validations.Choices, element) {
+				s.trigger(parent+"["+strconv.Itoa(i)+"]", "choices")
 				errors = append(errors, ValidationError{
 					Field:   parent + "[" + strconv.Itoa(i) + "]",
-					Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], reflect.ValueOf(element), choices),
+					Message: s.choiceMessage(parent+"["+strconv.Itoa(i)+"]", element, validations),
+					Code:    "choice",
 				})
 			}
 		}
 	}
 
-	// 3) Return the errors.
+	// 3) Reject the excluded values.
+	if validations.Excluded != nil {
+		for i, element := range parsedValues {
+			if containsChoice[T](validations, validations.Excluded, element) {
+				errors = append(errors, s.excludedError(parent+"["+strconv.Itoa(i)+"]", element))
+			}
+		}
+	}
+
+	// 4) Return the errors.
 	return errors
 }
 
-func removeDuplicate[T string | int | float64](sliceList []T) []T {
+func validateListPattern[T string | int | int64 | uint64 | float64](s *state, validations *Validations, parsedValues []T, parent string) []error {
+
+	// 1) Initialize an errors list.
+	var errors []error
+	if err := patternError(validations); err != nil {
+		return []error{err}
+	}
+
+	// 2) If we have received a pattern, match the string elements against it.
+	if validations.Pattern != nil {
+		for i, element := range parsedValues {
+			if value, ok := any(element).(string); ok && !validations.Pattern.MatchString(value) {
+				s.trigger(parent+"["+strconv.Itoa(i)+"]", "pattern")
+				errors = append(errors, ValidationError{
+					Field:   parent + "[" + strconv.Itoa(i) + "]",
+					Message: s.options.format("InvalidPattern", parent+"["+strconv.Itoa(i)+"]", validations.Pattern),
+					Code:    "pattern",
+				})
+			}
+		}
+	}
+
+	// 3) If we have received a format, prefixes, digits bounds or a checksum, check the string elements against them.
+	if format, ok := stringFormats[validations.Format]; ok || validations.Prefixes != nil || validations.MinDigits != 0 || validations.MaxDigits != 0 || validations.Checksum != "" {
+		for i, element := range parsedValues {
+			value, isString := any(element).(string)
+			switch {
+			case !isString:
+			case ok && !format.valid(value):
+				s.trigger(parent+"["+strconv.Itoa(i)+"]", "format")
+				errors = append(errors, ValidationError{
+					Field:   parent + "[" + strconv.Itoa(i) + "]",
+					Message: s.options.format(format.message, parent+"["+strconv.Itoa(i)+"]"),
+					Code:    "format",
+				})
+			case validations.Prefixes != nil && !hasPrefix(validations, value):
+				s.trigger(parent+"["+strconv.Itoa(i)+"]", "prefix")
+				errors = append(errors, ValidationError{
+					Field:   parent + "[" + strconv.Itoa(i) + "]",
+					Message: s.options.format("InvalidPrefix", parent+"["+strconv.Itoa(i)+"]", validations.Prefixes),
+					Code:    "prefix",
+				})
+			default:
+				errors = append(errors, s.validateDigits(validations, parent+"["+strconv.Itoa(i)+"]", value)...)
+				errors = append(errors, s.validateChecksum(validations, parent+"["+strconv.Itoa(i)+"]", value)...)
+			}
+		}
+	}
+
+	// 4) Reject the string elements with bidi control characters, emoji or markup.
+	if validations.DisallowBidi {
+		for i, element := range parsedValues {
+			if value, ok := any(element).(string); ok && hasBidiControl(value) {
+				s.trigger(parent+"["+strconv.Itoa(i)+"]", "allowBidi")
+				errors = append(errors, ValidationError{
+					Field:   parent + "[" + strconv.Itoa(i) + "]",
+					Message: s.options.format("InvalidBidi", parent+"["+strconv.Itoa(i)+"]"),
+					Code:    "bidi",
+				})
+			}
+		}
+	}
+	if validations.NoEmoji {
+		for i, element := range parsedValues {
+			if value, ok := any(element).(string); ok && hasEmoji(value) {
+				s.trigger(parent+"["+strconv.Itoa(i)+"]", "noEmoji")
+				errors = append(errors, ValidationError{
+					Field:   parent + "[" + strconv.Itoa(i) + "]",
+					Message: s.options.format("InvalidEmoji", parent+"["+strconv.Itoa(i)+"]"),
+					Code:    "emoji",
+				})
+			}
+		}
+	}
+	if validations.NoMarkup {
+		for i, element := range parsedValues {
+			if value, ok := any(element).(string); ok && hasMarkup(value) {
+				s.trigger(parent+"["+strconv.Itoa(i)+"]", "noMarkup")
+				errors = append(errors, ValidationError{
+					Field:   parent + "[" + strconv.Itoa(i) + "]",
+					Message: s.options.format("InvalidMarkup", parent+"["+strconv.Itoa(i)+"]"),
+					Code:    "markup",
+				})
+			}
+		}
+	}
+
+	// 5) Return the errors.
+	return errors
+}
+
+// stringFormats holds the checks of the "format=" values of the string fields, with the message key of their errors.
+var stringFormats = map[string]struct {
+	valid   func(value string) bool
+	message string
+}{
+	"email":     {isEmail, "InvalidEmail"},
+	"url":       {isUrl, "InvalidUrl"},
+	"uuid":      {uuidPattern.MatchString, "InvalidUuid"},
+	"ulid":      {ulidPattern.MatchString, "InvalidUlid"},
+	"ksuid":     {isKsuid, "InvalidKsuid"},
+	"base58":    {isBase58, "InvalidBase58"},
+	"bech32":    {isBech32, "InvalidBech32"},
+	"iso3166-2": {subdivisionPattern.MatchString, "InvalidSubdivision"},
+	"digits":    {isDigits, "InvalidDigits"},
+}
+
+// uuidPattern matches the UUIDs in their canonical textual form.
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// ulidPattern matches the ULIDs in their canonical Crockford's base32 form, the first character being at most 7 so
+// the 48 bits timestamp does not overflow.
+var ulidPattern = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`)
+
+// ksuidPattern matches the KSUIDs in their base62 form.
+var ksuidPattern = regexp.MustCompile(`^[0-9A-Za-z]{27}$`)
+
+// maxKsuid is the biggest KSUID, the base62 form of 20 bytes set to 0xff.
+const maxKsuid = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+// isKsuid reports whether the value is a KSUID. The base62 alphabet is in ASCII order, so the values that overflow the
+// 20 bytes of a KSUID are the ones after maxKsuid.
+func isKsuid(value string) bool {
+	return ksuidPattern.MatchString(value) && value <= maxKsuid
+}
+
+// isDigits reports whether the value is a non-empty string of ASCII digits, keeping the leading zeros that a number
+// would lose (e.g. "00123").
+func isDigits(value string) bool {
+	return value != "" && countDigits(value) == len(value)
+}
+
+// countDigits returns the number of ASCII digits of the value.
+func countDigits(value string) int {
+	var digits int
+	for i := 0; i < len(value); i++ {
+		if value[i] >= '0' && value[i] <= '9' {
+			digits++
+		}
+	}
+	return digits
+}
+
+// validateDigits checks the number of digits of the value against the "minDigits" and "maxDigits" bounds.
+func (s *state) validateDigits(validations *Validations, fieldName string, value string) []error {
+	var errors []error
+	digits := countDigits(value)
+	if validations.MinDigits != 0 && digits < validations.MinDigits {
+		s.trigger(fieldName, "minDigits")
+		errors = append(errors, ValidationError{
+			Field:   fieldName,
+			Message: s.options.format("InvalidMinDigits", fieldName, validations.MinDigits),
+			Code:    "min_digits",
+		})
+	}
+	if validations.MaxDigits != 0 && digits > validations.MaxDigits {
+		s.trigger(fieldName, "maxDigits")
+		errors = append(errors, ValidationError{
+			Field:   fieldName,
+			Message: s.options.format("InvalidMaxDigits", fieldName, validations.MaxDigits),
+			Code:    "max_digits",
+		})
+	}
+	return errors
+}
+
+// isEmail reports whether the value is a plain email address ("john@example.com", without a display name).
+func isEmail(value string) bool {
+	address, err := mail.ParseAddress(value)
+	return err == nil && address.Address == value
+}
+
+// isUrl reports whether the value is an absolute URL with a host.
+func isUrl(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && parsed.Scheme != "" && parsed.Host != ""
+}
+
+// hasBidiControl reports whether the value has a Unicode bidi control character (U+202A to U+202E and U+2066 to U+2069),
+// which can spoof the displayed text of filenames and URLs.
+func hasBidiControl(value string) bool {
+	for _, r := range value {
+		if r >= '\u202A' && r <= '\u202E' || r >= '\u2066' && r <= '\u2069' {
+			return true
+		}
+	}
+	return false
+}
+
+// hasEmoji reports whether the value has an emoji or a pictographic symbol (the Unicode "Symbol, other" category, e.g.
+// U+2764 or U+1F600, the skin tone modifiers, the emoji variation selector and the keycap), which the legacy systems
+// storing legal names or invoice references cannot store. The currency and math symbols are allowed.
+func hasEmoji(value string) bool {
+	for _, r := range value {
+		if unicode.Is(unicode.So, r) || r >= '\U0001F3FB' && r <= '\U0001F3FF' || r == '\uFE0F' || r == '\u20E3' {
+			return true
+		}
+	}
+	return false
+}
+
+// patterns caches the compiled patterns, since the tags are parsed again when the rules of a schema or the flags apply.
+var patterns sync.Map
+
+// compilePattern returns the compiled pattern, or nil if the pattern is not a valid regular expression.
+func compilePattern(pattern string) *regexp.Regexp {
+	if compiled, ok := patterns.Load(pattern); ok {
+		return compiled.(*regexp.Regexp)
+	}
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil
+	}
+	patterns.Store(pattern, compiled)
+	return compiled
+}
+
+// patternError returns a ConfigError when the "pattern=" rule of the validations is not a valid regular expression.
+func patternError(validations *Validations) error {
+	if validations.invalidPattern == "" {
+		return nil
+	}
+	return ConfigError{Message: fmt.Sprintf("the pattern %s is not a valid regular expression", validations.invalidPattern)}
+}
+
+func removeDuplicate[T string | int | int64 | uint64 | float64](sliceList []T) []T {
 	allKeys := make(map[T]bool)
 	var list []T
 	for _, item := range sliceList {
@@ -612,15 +1765,108 @@ func removeDuplicate[T string | int | float64](sliceList []T) []T {
 	return list
 }
 
-func contains[T string | int | float64](sliceList []any, value T) bool {
+func contains[T string | int | int64 | uint64 | float64](sliceList []any, value T) bool {
 	for _, element := range sliceList {
-		if reflect.ValueOf(element).Interface() == reflect.ValueOf(value).Interface() {
+		if reflect.ValueOf(element).Interface() == reflect.ValueOf(value).Interface() || sameInteger(element, value) {
 			return true
 		}
 	}
 	return false
 }
 
+// sameInteger reports whether both values are integers, of any size, with the same value (e.g. the int choices of
+// the tags and the int64 or uint64 values of the sized integer fields).
+func sameInteger(a, b any) bool {
+	isInteger := func(value any) bool {
+		switch value.(type) {
+		case int, int64, uint64:
+			return true
+		}
+		return false
+	}
+	return isInteger(a) && isInteger(b) && fmt.Sprint(a) == fmt.Sprint(b)
+}
+
+// containsChoice reports whether the value is one of the choices (or of the excluded values) of the validations, the
+// floats within the epsilon of a choice when declared.
+func containsChoice[T string | int | int64 | uint64 | float64](validations *Validations, choices []any, value T) bool {
+	if number, isFloat := any(value).(float64); isFloat && validations.Epsilon > 0 {
+		for _, choice := range choices {
+			if choiceNumber, ok := choice.(float64); ok && math.Abs(number-choiceNumber) <= validations.Epsilon {
+				return true
+			}
+		}
+		return false
+	}
+	return contains[T](choices, value)
+}
+
+// excludedError returns the ExcludedValue error of a value of the "exclude=" values.
+func (s *state) excludedError(fieldName string, value any) ValidationError {
+	s.trigger(fieldName, "exclude")
+	return ValidationError{
+		Field:   fieldName,
+		Message: s.options.format("ExcludedValue", fieldName, value),
+		Code:    "excluded",
+	}
+}
+
+// pointerTypes are the field types the scalar types are bound into.
+var pointerTypes = map[string]reflect.Type{
+	"string":   reflect.TypeOf((*string)(nil)),
+	"int":      reflect.TypeOf((*int)(nil)),
+	"float":    reflect.TypeOf((*float64)(nil)),
+	"bool":     reflect.TypeOf((*bool)(nil)),
+	"datetime": reflect.TypeOf((*time.Time)(nil)),
+	"geo":      reflect.TypeOf((*GeoPoint)(nil)),
+	"money":    reflect.TypeOf((*Money)(nil)),
+}
+
+// setValue sets the field of the validations to value. The fields of the expected pointer type are set through their
+// offset in the form, which avoids the lookup by name and reflect.Value.Set on the hot path. The value fields (e.g.
+// string instead of *string) are set to the pointed value, and the double pointer fields (e.g. **string) to a pointer
+// to value.
+func setValue[T any](form reflect.Value, validations *Validations, value *T) {
+	switch {
+	case validations.nullField:
+		field := formField(form, validations)
+		pointer := reflect.New(field.Type().Elem())
+		pointer.Elem().Set(reflect.ValueOf(value))
+		field.Set(pointer)
+	case validations.unsafeSet && form.CanAddr():
+		*(**T)(unsafe.Add(form.Addr().UnsafePointer(), validations.offset)) = value
+	case validations.valueField && form.CanAddr() && !validations.indirect:
+		*(*T)(unsafe.Add(form.Addr().UnsafePointer(), validations.offset)) = *value
+	case validations.valueField:
+		formField(form, validations).Set(reflect.ValueOf(*value))
+	default:
+		formField(form, validations).Set(reflect.ValueOf(value))
+	}
+}
+
+// setField sets a form field, allocating the pointers of the pointer fields (e.g. *[]string, or both levels of a
+// **int32) so that an empty list can be told apart from an absent one, and a value from null.
+func setField(field, value reflect.Value) {
+	if field.Kind() == reflect.Pointer && value.Kind() == reflect.Slice && value.IsNil() {
+		value = reflect.MakeSlice(value.Type(), 0, 0)
+	}
+	for value.Type() != field.Type() && pointerDepth(field.Type()) > pointerDepth(value.Type()) {
+		pointer := reflect.New(value.Type())
+		pointer.Elem().Set(value)
+		value = pointer
+	}
+	field.Set(value)
+}
+
+// pointerDepth returns the number of pointer levels of a type (2 for a **int32).
+func pointerDepth(valueType reflect.Type) int {
+	depth := 0
+	for ; valueType.Kind() == reflect.Pointer; valueType = valueType.Elem() {
+		depth++
+	}
+	return depth
+}
+
 func getFieldName(parent, fieldName string) string {
 	if reflect.ValueOf(parent).IsZero() {
 		return fieldName
@@ -628,3 +1874,86 @@ func getFieldName(parent, fieldName string) string {
 		return parent + "." + fieldName
 	}
 }
+
+func (s *state) recordCoercion(fieldName string, fieldNode int, declaredType string) {
+
+	// 1) Get the JSON type of the received value.
+	jsonType := s.document.kind(fieldNode).String()
+
+	// 2) Values that already have the declared type were not coerced.
+	if !isCoercion(jsonType, declaredType) {
+		return
+	}
+
+	// 3) Record the coercion.
+	s.coercions = append(s.coercions, Coercion{
+		Field: fieldName,
+		From:  jsonType,
+		To:    declaredType,
+		Value: s.document.value(fieldNode),
+	})
+	if s.options.coercionReport != nil {
+		s.options.coercionReport(s.coercions[len(s.coercions)-1])
+	}
+}
+
+// isCoercion reports whether a value of the JSON type must be coerced into the declared type.
+func isCoercion(jsonType, declaredType string) bool {
+	return jsonType != declaredType && !(jsonType == "number" && (declaredType == "int" || declaredType == "float"))
+}
+
+// strictRejects reports whether the value must be rejected because it would be coerced into the declared type while
+// the strict mode applies (strict=true or WithStrictTypes). The values of headers, path parameters, multipart... are
+// always strings and are never rejected.
+func (s *state) strictRejects(validations *Validations, fieldNode int, declaredType string) bool {
+	if !validations.Strict && !s.options.strictTypes || s.options.fromValues {
+		return false
+	}
+	return isCoercion(s.document.kind(fieldNode).String(), declaredType)
+}
+
+// formatError returns the InvalidFormat error of a node, positioned at the node in the json data.
+func (s *state) formatError(fieldName string, fieldNode int) ValidationError {
+	s.trigger(fieldName, "type")
+	validationError := ValidationError{
+		Field:   fieldName,
+		Message: s.options.format("InvalidFormat", fieldName, s.document.value(fieldNode)),
+		Code:    "invalid_type",
+	}
+	if !s.options.fromValues {
+		validationError.Line, validationError.Column = position(s.document.data, s.document.nodes[fieldNode].start)
+	}
+	return validationError
+}
+
+// choiceMessage returns the InvalidChoice message, displaying the choices labels and limiting the displayed
+// choices according to the options.
+func (s *state) choiceMessage(fieldName string, value any, validations *Validations) string {
+
+	// 1) Display the labels instead of the values, when declared.
+	choices := validations.Choices
+	if validations.ChoiceLabels != nil {
+		choices = make([]any, len(validations.Choices))
+		for i, label := range validations.ChoiceLabels {
+			if label != "" {
+				choices[i] = label
+			} else {
+				choices[i] = validations.Choices[i]
+			}
+		}
+	}
+
+	// 2) Omit the choices.
+	if s.options.omitChoices {
+		return s.options.format("InvalidChoiceWithoutList", fieldName, value)
+	}
+
+	// 3) Truncate the choices.
+	if limit := s.options.choicesLimit; limit > 0 && len(choices) > limit {
+		displayed := s.options.format("TruncatedChoices", fieldName, choices[:limit], len(choices)-limit)
+		return s.options.format("InvalidChoice", fieldName, value, displayed)
+	}
+
+	// 4) Display all the choices.
+	return s.options.format("InvalidChoice", fieldName, value, choices)
+}
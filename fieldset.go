@@ -0,0 +1,131 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ValidateFieldSet validates a sparse fieldset ("name,person.email") against the fields declared in a form and
+// returns the selected fields in order, without duplicates. The fields of the inner structs are selected with their
+// path, and the unknown fields are returned with the closest declared field as a suggestion.
+func ValidateFieldSet(fieldSet string, form any, opts ...Option) ([]string, []error) {
+
+	// 1) Get the form type and the options.
+	formType := structType(reflect.TypeOf(form))
+	o := newOptions(opts)
+
+	// 2) Check each selected field.
+	var errors []error
+	var fields []string
+	selected := make(map[string]bool)
+	for _, field := range strings.Split(fieldSet, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" || selected[field] {
+			continue
+		}
+		if suggestion, ok := fieldSetLookup(formType, field, o.syntax()); !ok {
+			message := o.format("UnknownField", field)
+			if suggestion != "" {
+				message = o.format("UnknownFieldSuggestion", field, suggestion)
+			}
+			errors = append(errors, ValidationError{Field: getFieldName(o.pathPrefix, field), Message: message, Code: "unknown_field"})
+			continue
+		}
+		selected[field] = true
+		fields = append(fields, field)
+	}
+
+	// 3) Return the fields or the errors.
+	if errors != nil {
+		return nil, errors
+	}
+	return fields, nil
+}
+
+// fieldSetLookup resolves the path of a field in a form type, returning the closest declared field when the path
+// does not exist.
+func fieldSetLookup(formType reflect.Type, path string, syntax tagSyntax) (string, bool) {
+
+	// 1) Walk the path through the inner structs.
+	var parent string
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		if formType.Kind() != reflect.Struct {
+			return "", false
+		}
+		validationsMap := getValidations(reflect.New(formType).Elem(), syntax)
+		validations, ok := validationsMap[name]
+
+		// 2) Suggest the closest field of the current struct.
+		if !ok {
+			declared := make([]string, 0, len(validationsMap))
+			for fieldName := range validationsMap {
+				declared = append(declared, fieldName)
+			}
+			if suggestion := closestField(name, declared); suggestion != "" {
+				return getFieldName(parent, suggestion), false
+			}
+			return "", false
+		}
+		if i < len(names)-1 {
+			field, _ := formType.FieldByName(validations.structField)
+			formType = structType(field.Type)
+			parent = getFieldName(parent, name)
+		}
+	}
+
+	// 3) The path exists.
+	return "", true
+}
+
+// closestField returns the declared field with the smallest edit distance to the name, when it is at most a third of
+// the name length (at least 1), or "" when none is close enough.
+func closestField(name string, declared []string) string {
+	sort.Strings(declared)
+	limit := len(name) / 3
+	if limit < 1 {
+		limit = 1
+	}
+	var closest string
+	for _, fieldName := range declared {
+		if distance := editDistance(strings.ToLower(name), strings.ToLower(fieldName)); distance <= limit {
+			closest, limit = fieldName, distance-1
+		}
+	}
+	return closest
+}
+
+// editDistance returns the optimal string alignment distance between two strings, the Levenshtein distance where the
+// transposition of two adjacent characters ("nmae") is a single edit.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	rows := make([][]int, len(ra)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(rb)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			distance := rows[i-1][j-1] + cost
+			if rows[i-1][j]+1 < distance {
+				distance = rows[i-1][j] + 1
+			}
+			if rows[i][j-1]+1 < distance {
+				distance = rows[i][j-1] + 1
+			}
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] && rows[i-2][j-2]+1 < distance {
+				distance = rows[i-2][j-2] + 1
+			}
+			rows[i][j] = distance
+		}
+	}
+	return rows[len(ra)][len(rb)]
+}
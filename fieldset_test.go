@@ -0,0 +1,60 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateFieldSet(t *testing.T) {
+	type address struct {
+		City    *string `validations:"type=string"`
+		Country *string `validations:"type=string"`
+	}
+	type person struct {
+		Name    *string  `validations:"type=string"`
+		Email   *string  `validations:"type=string"`
+		Address *address `validations:"type=struct"`
+	}
+	tests := []struct {
+		name     string
+		fieldSet string
+		want     []string
+		wantErr  []error
+	}{
+		{
+			name:     "test_fields",
+			fieldSet: "name, address.city,name",
+			want:     []string{"name", "address.city"},
+			wantErr:  nil,
+		},
+		{
+			name:     "test_unknown_field_suggestion",
+			fieldSet: "nmae,address.cuntry",
+			want:     nil,
+			wantErr: []error{
+				ValidationError{Field: "nmae", Message: defaultMessage("UnknownFieldSuggestion", "name"), Code: "unknown_field"},
+				ValidationError{Field: "address.cuntry", Message: defaultMessage("UnknownFieldSuggestion", "address.country"), Code: "unknown_field"},
+			},
+		},
+		{
+			name:     "test_unknown_field",
+			fieldSet: "password,name.first",
+			want:     nil,
+			wantErr: []error{
+				ValidationError{Field: "password", Message: DefaultMessages["UnknownField"], Code: "unknown_field"},
+				ValidationError{Field: "name.first", Message: DefaultMessages["UnknownField"], Code: "unknown_field"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotErr := ValidateFieldSet(tt.fieldSet, new(person))
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("ValidateFieldSet() errors = %v, want %v", gotErr, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateFieldSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
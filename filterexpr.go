@@ -0,0 +1,68 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"strings"
+)
+
+// FilterOperators are the operators of the filter expressions, when the fields do not declare "operators=".
+var FilterOperators = []string{"eq", "ne", "gt", "gte", "lt", "lte"}
+
+// FilterTerm is a term of a filter expression, bound into the []FilterTerm fields declared with "format=filterexpr".
+type FilterTerm struct {
+	Field    string
+	Operator string
+	Value    string
+}
+
+// validateFilterExpression validates a filter expression ("status:eq:active,age:gte:18") against the filterable fields
+// and operators and binds its terms into the field.
+func (s *state) validateFilterExpression(validations *Validations, fieldName string, value string, form reflect.Value) []error {
+
+	// 1) Get the operators of the field.
+	operators := validations.Operators
+	if operators == nil {
+		operators = FilterOperators
+	}
+
+	// 2) Parse each "field:operator:value" term, the values can contain ":".
+	var errors []error
+	var terms []FilterTerm
+	for _, term := range strings.Split(value, ",") {
+		parts := strings.SplitN(strings.TrimSpace(term), ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+			s.trigger(fieldName, "format")
+			return []error{ValidationError{
+				Field:   fieldName,
+				Message: s.options.format("InvalidFilterExpression", fieldName, value),
+				Code:    "format",
+			}}
+		}
+		if validations.Fields != nil && !containsAny(toAny(validations.Fields), parts[0]) {
+			s.trigger(fieldName, "fields")
+			errors = append(errors, ValidationError{
+				Field:   fieldName,
+				Message: s.choiceMessage(fieldName, parts[0], &Validations{Choices: toAny(validations.Fields)}),
+				Code:    "choice",
+			})
+		}
+		if !containsAny(toAny(operators), parts[1]) {
+			s.trigger(fieldName, "operators")
+			errors = append(errors, ValidationError{
+				Field:   fieldName,
+				Message: s.choiceMessage(fieldName, parts[1], &Validations{Choices: toAny(operators)}),
+				Code:    "choice",
+			})
+		}
+		terms = append(terms, FilterTerm{Field: parts[0], Operator: parts[1], Value: parts[2]})
+	}
+	if errors != nil {
+		return errors
+	}
+
+	// 3) Update form with the terms.
+	setField(formField(form, validations), reflect.ValueOf(terms))
+
+	// 4) Return errors.
+	return nil
+}
@@ -0,0 +1,60 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidate_FilterExpression(t *testing.T) {
+	type createObject struct {
+		Filter []FilterTerm `validations:"type=string;format=filterexpr;fields=status,age;operators=eq,gte"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+		wantForm *createObject
+	}{
+		{
+			name:     "test_filter_expression",
+			jsonData: []byte(`{"filter": "status:eq:active, age:gte:18"}`),
+			want:     nil,
+			wantForm: &createObject{Filter: []FilterTerm{{Field: "status", Operator: "eq", Value: "active"}, {Field: "age", Operator: "gte", Value: "18"}}},
+		},
+		{
+			name:     "test_filter_expression_value_separator",
+			jsonData: []byte(`{"filter": "status:eq:a:b"}`),
+			want:     nil,
+			wantForm: &createObject{Filter: []FilterTerm{{Field: "status", Operator: "eq", Value: "a:b"}}},
+		},
+		{
+			name:     "test_filter_expression_unknown_field_and_operator",
+			jsonData: []byte(`{"filter": "password:eq:secret,age:lt:18"}`),
+			want: []error{
+				ValidationError{Field: "filter", Message: defaultMessage("InvalidChoice", "password", []any{"status", "age"}), Code: "choice"},
+				ValidationError{Field: "filter", Message: defaultMessage("InvalidChoice", "lt", []any{"eq", "gte"}), Code: "choice"},
+			},
+			wantForm: &createObject{},
+		},
+		{
+			name:     "test_filter_expression_invalid",
+			jsonData: []byte(`{"filter": "status:active"}`),
+			want: []error{
+				ValidationError{Field: "filter", Message: defaultMessage("InvalidFilterExpression", "status:active"), Code: "format"},
+			},
+			wantForm: &createObject{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := Validate(tt.jsonData, form)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(form, tt.wantForm) {
+				t.Errorf("Validate() form = %+v, want %+v", form, tt.wantForm)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package jsonValidator
+
+import (
+	"math"
+)
+
+// nonFiniteErrors returns the error of a NaN or infinite float coerced from a string ("NaN", "Inf", "-Infinity"...),
+// unless the WithNonFiniteFloats option allows them. The json numbers are always finite.
+func (s *state) nonFiniteErrors(fieldName string, fieldNode int, value any) []error {
+	if number, ok := value.(float64); !ok || !math.IsNaN(number) && !math.IsInf(number, 0) || s.options.allowNonFinite {
+		return nil
+	}
+	validationError := s.formatError(fieldName, fieldNode)
+	validationError.Message = s.options.format("NonFiniteNumber", fieldName, s.document.value(fieldNode))
+	validationError.Code = "non_finite"
+	return []error{validationError}
+}
@@ -0,0 +1,78 @@
+package jsonValidator
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_NonFinite(t *testing.T) {
+	type createObject struct {
+		Price    *float64  `validations:"type=float"`
+		Prices   []float64 `validations:"type=[]float"`
+		Location *GeoPoint `validations:"type=geo"`
+		Total    *Money    `validations:"type=money"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		opts     []Option
+		wantErr  []error
+	}{
+		{
+			name:     "test_finite",
+			jsonData: []byte("{\"price\": \"1.5\", \"prices\": [\"2\", 3], \"location\": {\"lat\": \"1\", \"lng\": 2}, \"total\": \"9.99 EUR\"}"),
+		},
+		{
+			name:     "test_non_finite",
+			jsonData: []byte("{\"price\": \"NaN\", \"prices\": [1, \"Inf\"], \"location\": {\"lat\": \"nan\", \"lng\": 2}, \"total\": {\"amount\": \"-Infinity\", \"currency\": \"EUR\"}}"),
+			wantErr: []error{
+				ValidationError{Field: "price", Message: defaultMessage("NonFiniteNumber", "NaN"), Code: "non_finite", Line: 1, Column: 11},
+				ValidationError{Field: "prices[1]", Message: defaultMessage("NonFiniteNumber", "Inf"), Code: "non_finite", Line: 1, Column: 32},
+				ValidationError{Field: "location.lat", Message: defaultMessage("NonFiniteNumber", "nan"), Code: "non_finite", Line: 1, Column: 60},
+				ValidationError{Field: "total.amount", Message: defaultMessage("NonFiniteNumber", "-Infinity"), Code: "non_finite", Line: 1, Column: 98},
+			},
+		},
+		{
+			name:     "test_non_finite_money_string",
+			jsonData: []byte("{\"total\": \"Inf EUR\"}"),
+			wantErr: []error{
+				ValidationError{Field: "total", Message: defaultMessage("NonFiniteNumber", "Inf EUR"), Code: "non_finite", Line: 1, Column: 11},
+			},
+		},
+		{
+			name:     "test_non_finite_allowed",
+			jsonData: []byte("{\"price\": \"NaN\", \"prices\": [\"Inf\"]}"),
+			opts:     []Option{WithNonFiniteFloats()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(createObject)
+			gotErr := Validate(tt.jsonData, got, tt.opts...)
+
+			// Sort
+			sort.Sort(Errors(gotErr))
+			sort.Sort(Errors(tt.wantErr))
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate_NonFiniteAllowedValues(t *testing.T) {
+	type createObject struct {
+		Price  *float64  `validations:"type=float"`
+		Prices []float64 `validations:"type=[]float"`
+	}
+	got := new(createObject)
+	if errs := Validate([]byte("{\"price\": \"NaN\", \"prices\": [\"-Inf\"]}"), got, WithNonFiniteFloats()); errs != nil {
+		t.Fatalf("Validate() = %v, want nil", errs)
+	}
+	if !math.IsNaN(*got.Price) || !reflect.DeepEqual(got.Prices, []float64{math.Inf(-1)}) {
+		t.Errorf("Validate() form = %v %v, want NaN [-Inf]", *got.Price, got.Prices)
+	}
+}
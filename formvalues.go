@@ -0,0 +1,142 @@
+package jsonValidator
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ValidateFormValues validates url-encoded values (an application/x-www-form-urlencoded body or a query string) against
+// a form received and update the form with the parsed data, with the same tags as the json bodies. The values are
+// coerced from their strings into the types of the fields, the keys reach the inner forms with dots and indexes
+// ("person.name", "personList[0].name"), the lists are the repeated keys ("owners=a&owners=b", or "owners[]=a") and,
+// unlike ValidateQuery, the keys that are not declared in the form are rejected.
+func ValidateFormValues(values url.Values, form any, opts ...Option) []error {
+
+	// 1) Convert the values into a json object, following the fields of the form.
+	formValue, err := formValueOf(form)
+	if err != nil {
+		return []error{err}
+	}
+	o := newOptions(opts)
+	jsonData, _ := json.Marshal(formValuesObject(values, formValue.Type(), o.syntax()))
+
+	// 2) Validate the json object, coercing the strings.
+	o.fromValues = true
+	return validate(jsonData, form, o)
+}
+
+// formEntry is the values of a url-encoded key, with the path of the key left to resolve.
+type formEntry struct {
+	path   []string
+	values []string
+}
+
+// formValuesObject converts the url-encoded values into a json object of the form type.
+func formValuesObject(values url.Values, formType reflect.Type, syntax tagSyntax) map[string]any {
+	entries := make([]formEntry, 0, len(values))
+	for key, keyValues := range values {
+		entries = append(entries, formEntry{path: formValuePath(key), values: keyValues})
+	}
+	return formEntriesObject(entries, formType, syntax)
+}
+
+// formValuePath splits a key into the names and the indexes of its path ("personList[0].name" into "personList", "0"
+// and "name"), dropping the "[]" suffix of the lists.
+func formValuePath(key string) []string {
+	var path []string
+	for _, segment := range strings.Split(strings.TrimSuffix(key, "[]"), ".") {
+		name, indexes, _ := strings.Cut(segment, "[")
+		path = append(path, name)
+		if indexes != "" {
+			path = append(path, strings.Split(strings.TrimSuffix(indexes, "]"), "][")...)
+		}
+	}
+	return path
+}
+
+func formEntriesObject(entries []formEntry, formType reflect.Type, syntax tagSyntax) map[string]any {
+
+	// 1) Get the validations of the form, nil for the objects that are not forms (e.g. the unknown fields).
+	var validationsMap map[string]*Validations
+	if formType != nil && formType.Kind() == reflect.Struct {
+		validationsMap = getValidations(reflect.New(formType).Elem(), syntax)
+	}
+
+	// 2) Set the values of the keys, grouping the keys of the inner objects by field.
+	object := make(map[string]any)
+	inner := make(map[string][]formEntry)
+	for _, entry := range entries {
+		name := entry.path[0]
+		validations := validationsMap[name]
+		switch {
+		case len(entry.path) > 1:
+			inner[name] = append(inner[name], formEntry{path: entry.path[1:], values: entry.values})
+		case validations != nil && strings.HasPrefix(validations.Type, "[]") || validations == nil && len(entry.values) > 1:
+			object[name] = entry.values
+		case len(entry.values) > 0:
+			object[name] = entry.values[0]
+		default:
+			object[name] = nil
+		}
+	}
+
+	// 3) Convert the inner objects, into lists when their keys are indexes.
+	for name, innerEntries := range inner {
+		var innerType reflect.Type
+		if validations := validationsMap[name]; validations != nil {
+			if field, ok := formType.FieldByName(validations.structField); ok {
+				innerType = structType(field.Type)
+			}
+		}
+		object[name] = formEntriesValue(innerEntries, innerType, syntax)
+	}
+
+	// 4) Return the object.
+	return object
+}
+
+// formEntriesValue converts the entries of an inner object into a list when all their keys are indexes, with the
+// elements in the order of their indexes, and into an object otherwise.
+func formEntriesValue(entries []formEntry, formType reflect.Type, syntax tagSyntax) any {
+
+	// 1) Group the entries by index, the values of the scalar elements apart.
+	scalars := make(map[int]string)
+	objects := make(map[int][]formEntry)
+	for _, entry := range entries {
+		index, err := strconv.Atoi(entry.path[0])
+		if err != nil || index < 0 {
+			return formEntriesObject(entries, formType, syntax)
+		}
+		switch {
+		case len(entry.path) > 1:
+			objects[index] = append(objects[index], formEntry{path: entry.path[1:], values: entry.values})
+		case len(entry.values) > 0:
+			scalars[index] = entry.values[0]
+		}
+	}
+
+	// 2) Convert the elements in the order of their indexes.
+	indexes := make([]int, 0, len(scalars)+len(objects))
+	for index := range scalars {
+		indexes = append(indexes, index)
+	}
+	for index := range objects {
+		if _, ok := scalars[index]; !ok {
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Ints(indexes)
+	list := make([]any, len(indexes))
+	for i, index := range indexes {
+		if scalar, ok := scalars[index]; ok {
+			list[i] = scalar
+		} else {
+			list[i] = formEntriesObject(objects[index], formType, syntax)
+		}
+	}
+	return list
+}
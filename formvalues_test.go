@@ -0,0 +1,124 @@
+package jsonValidator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestValidateFormValues(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string;required=true"`
+		Age  *int    `validations:"type=int"`
+	}
+	type createObject struct {
+		Name       *string  `validations:"type=string;required=true"`
+		Code       *int     `validations:"type=int;choices=1,2,3"`
+		Price      *float64 `validations:"type=float"`
+		Successful *bool    `validations:"type=bool"`
+		Owners     []string `validations:"type=[]string"`
+		Codes      []int    `validations:"type=[]int"`
+		Person     *Person  `validations:"type=struct"`
+		PersonList []Person `validations:"type=[]struct"`
+	}
+	tests := []struct {
+		name     string
+		values   string
+		want     []error
+		wantForm createObject
+	}{
+		{
+			name:   "test_form_values",
+			values: "name=Daniel&code=2&price=9.5&successful=true&owners=a&owners=b&codes[]=1&codes[]=2&person.name=Silva&person.age=26&personList[1].name=B&personList[0].name=A",
+			wantForm: createObject{
+				Name:       toStringPointer("Daniel"),
+				Code:       toIntPointer(2),
+				Price:      toFloatPointer(9.5),
+				Successful: toBoolPointer(true),
+				Owners:     []string{"a", "b"},
+				Codes:      []int{1, 2},
+				Person:     &Person{Name: toStringPointer("Silva"), Age: toIntPointer(26)},
+				PersonList: []Person{{Name: toStringPointer("A")}, {Name: toStringPointer("B")}},
+			},
+		},
+		{
+			name:   "test_form_values_errors",
+			values: "code=4&price=abc&person.age=x&personList[0].age=1&unknown=1",
+			want: []error{
+				ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "code", Message: defaultMessage("InvalidChoice", 4, []any{1, 2, 3}), Code: "choice"},
+				ValidationError{Field: "price", Message: defaultMessage("InvalidFormat", "abc"), Code: "invalid_type"},
+				ValidationError{Field: "person.age", Message: defaultMessage("InvalidFormat", "x"), Code: "invalid_type"},
+				ValidationError{Field: "person.name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "personList[0].name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "unknown", Message: DefaultMessages["InvalidField"], Code: "unknown_field"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.values)
+			if err != nil {
+				t.Fatal(err)
+			}
+			form := new(createObject)
+			got := ValidateFormValues(values, form)
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateFormValues() = %v, want %v", got, tt.want)
+			}
+			if tt.want == nil && !reflect.DeepEqual(*form, tt.wantForm) {
+				t.Errorf("ValidateFormValues() form = %v, want %v", *form, tt.wantForm)
+			}
+		})
+	}
+}
+
+func TestValidateRequest_FormUrlEncoded(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string;required=true"`
+		Age  *int    `validations:"type=int"`
+		Page *int    `validations:"in=query;type=int"`
+	}
+	tests := []struct {
+		name     string
+		body     string
+		want     []error
+		wantForm createObject
+	}{
+		{
+			name:     "test_form_body",
+			body:     "name=Daniel&age=26",
+			wantForm: createObject{Name: toStringPointer("Daniel"), Age: toIntPointer(26), Page: toIntPointer(2)},
+		},
+		{
+			name: "test_form_empty_body",
+			body: "",
+			want: []error{
+				ValidationError{Field: "body.name", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+			wantForm: createObject{Page: toIntPointer(2)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/?page=2", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+			form := new(createObject)
+			if got := ValidateRequest(r, nil, form); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateRequest() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(*form, tt.wantForm) {
+				t.Errorf("ValidateRequest() form = %v, want %v", *form, tt.wantForm)
+			}
+		})
+	}
+}
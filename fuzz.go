@@ -0,0 +1,97 @@
+package jsonValidator
+
+import (
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"sort"
+	"strings"
+)
+
+// FuzzForms holds the forms exercised by Fuzz, indexed by name.
+var FuzzForms = map[string]reflect.Type{}
+
+// RegisterFuzzForm registers the type of form (a struct or a pointer to a struct) under the given name, so Fuzz
+// validates the fuzzed data against it.
+func RegisterFuzzForm(name string, form any) {
+	FuzzForms[name] = structType(reflect.TypeOf(form))
+}
+
+// PanicError is returned by Fuzz when the validation of the data against a form panics.
+type PanicError struct {
+	Form  string
+	Value any
+	Stack []byte
+}
+
+func (pe PanicError) Error() string {
+	return fmt.Sprintf("jsonValidator: validating against %s panicked: %v\n%s", pe.Form, pe.Value, pe.Stack)
+}
+
+// Fuzz validates the data against a new form of each registered type (by name order) and returns the first panic as a
+// PanicError. The validation errors are the expected outcome of most fuzzed data and are not returned, so it can be
+// called as is from a fuzz test:
+//
+//	f.Fuzz(func(t *testing.T, data []byte) {
+//		if err := jsonValidator.Fuzz(data); err != nil {
+//			t.Fatal(err)
+//		}
+//	})
+func Fuzz(data []byte, opts ...Option) error {
+	for _, name := range fuzzFormNames() {
+		if err := fuzzForm(name, FuzzForms[name], data, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fuzzForm validates the data against a new form of the type, recovering its panic.
+func fuzzForm(name string, formType reflect.Type, data []byte, opts []Option) (err error) {
+	defer func() {
+		if value := recover(); value != nil {
+			err = PanicError{Form: name, Value: value, Stack: debug.Stack()}
+		}
+	}()
+	Validate(data, reflect.New(formType).Interface(), opts...)
+	return nil
+}
+
+// FuzzCorpus returns the seed corpus of the registered forms, to be added with f.Add: the payloads of their conformance
+// cases (see GenerateConformanceCases) and a few malformed documents (empty, truncated, of other types, deeply nested).
+func FuzzCorpus() [][]byte {
+
+	// 1) Add the malformed documents.
+	corpus := [][]byte{
+		[]byte(""),
+		[]byte("null"),
+		[]byte("[]"),
+		[]byte("\"\""),
+		[]byte("{"),
+		[]byte("{\"\":"),
+		[]byte("{\"a\": [1, {\"b\": null}"),
+		[]byte(strings.Repeat("[", 512) + strings.Repeat("]", 512)),
+		[]byte(strings.Repeat("{\"a\":", 512) + "1" + strings.Repeat("}", 512)),
+	}
+
+	// 2) Add the payloads of the conformance cases of each form, and their truncations.
+	for _, name := range fuzzFormNames() {
+		for _, conformanceCase := range GenerateConformanceCases(reflect.New(FuzzForms[name]).Interface()) {
+			payload := []byte(conformanceCase.Payload)
+			corpus = append(corpus, payload, payload[:len(payload)/2])
+		}
+	}
+
+	// 3) Return the corpus.
+	return corpus
+}
+
+// fuzzFormNames returns the names of the registered forms, sorted.
+func fuzzFormNames() []string {
+	names := make([]string, 0, len(FuzzForms))
+	for name := range FuzzForms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
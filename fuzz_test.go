@@ -0,0 +1,105 @@
+package jsonValidator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type fuzzPerson struct {
+	Name *string `validations:"type=string;required=true;min=1;max=20"`
+	Age  *int    `validations:"type=int;min=0;max=150"`
+}
+
+type fuzzObject struct {
+	Code       *string           `validations:"type=string;required=true;choices=a,b,c"`
+	Price      *float64          `validations:"type=float;min=0"`
+	Tags       []string          `validations:"type=[]string;max=3"`
+	Person     *fuzzPerson       `validations:"type=struct"`
+	PersonList []*fuzzPerson     `validations:"type=[]struct;max=5"`
+	Labels     map[string]string `validations:"type=map[string]string"`
+}
+
+func TestFuzz(t *testing.T) {
+	RegisterFuzzForm("object", new(fuzzObject))
+	RegisterValidation("explode", func(value any, param string) error {
+		if value.(string) == "boom" {
+			panic("exploded")
+		}
+		return nil
+	})
+	type explodingObject struct {
+		Name *string `validations:"type=string;custom=explode"`
+	}
+	defer func() {
+		delete(FuzzForms, "object")
+		delete(FuzzForms, "exploding")
+		delete(CustomValidations, "explode")
+	}()
+	tests := []struct {
+		name      string
+		exploding bool
+		data      []byte
+		wantPanic bool
+	}{
+		{
+			name: "test_fuzz_valid",
+			data: []byte("{\"code\": \"a\", \"name\": \"boom\"}"),
+		},
+		{
+			name: "test_fuzz_invalid",
+			data: []byte("{\"code\": 1, \"personList\": [null, 1]"),
+		},
+		{
+			name:      "test_fuzz_exploding_form",
+			exploding: true,
+			data:      []byte("{\"name\": \"boom\"}"),
+			wantPanic: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.exploding {
+				RegisterFuzzForm("exploding", explodingObject{})
+			}
+			err := Fuzz(tt.data)
+			var panicError PanicError
+			if gotPanic := errors.As(err, &panicError); gotPanic != tt.wantPanic {
+				t.Fatalf("Fuzz() = %v, want panic %v", err, tt.wantPanic)
+			}
+			if tt.wantPanic && (panicError.Form != "exploding" || panicError.Value != "exploded" || !strings.Contains(err.Error(), "exploded")) {
+				t.Errorf("Fuzz() = %+v, want the panic of the exploding form", panicError)
+			}
+		})
+	}
+}
+
+func TestFuzzCorpus(t *testing.T) {
+	RegisterFuzzForm("object", new(fuzzObject))
+	defer delete(FuzzForms, "object")
+
+	corpus := FuzzCorpus()
+	valid := 0
+	for _, data := range corpus {
+		if Validate(data, new(fuzzObject)) == nil {
+			valid++
+		}
+	}
+	if valid == 0 {
+		t.Errorf("FuzzCorpus() = %d payloads, want at least one valid payload", len(corpus))
+	}
+}
+
+func FuzzValidate(f *testing.F) {
+	RegisterFuzzForm("object", new(fuzzObject))
+	defer delete(FuzzForms, "object")
+
+	for _, data := range FuzzCorpus() {
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := Fuzz(data); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,137 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// GeoPoint is the value bound into the "type=geo" fields, from a {"lat": ..., "lng": ...} object.
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+func (s *state) validateGeo(validations *Validations, fieldName string, fieldNode int, form reflect.Value, parent string) []error {
+
+	// 1) Validate the fieldNode type, null is only accepted by the nullable fields.
+	if s.document.kind(fieldNode) != kindObject {
+		return []error{s.formatError(getFieldName(parent, fieldName), fieldNode)}
+	}
+	field := getFieldName(parent, fieldName)
+
+	// 2) Reject the unknown members, unless allowed.
+	var errors []error
+	for keyNode := s.document.nodes[fieldNode].first; keyNode != 0; keyNode = s.document.nodes[keyNode].next {
+		if name := s.document.stringValue(keyNode); name != "lat" && name != "lng" && !s.options.allowUnknownFields {
+			errors = append(errors, ValidationError{
+				Field:   getFieldName(field, name),
+				Message: s.options.format("InvalidField", getFieldName(field, name)),
+				Code:    "unknown_field",
+			})
+		}
+	}
+
+	// 3) The coordinates are both present or both absent, which leaves the field empty like a missing key.
+	latNode, lngNode := s.document.member(fieldNode, "lat"), s.document.member(fieldNode, "lng")
+	if latNode < 0 && lngNode < 0 {
+		if validations.Required && !s.options.partial {
+			s.trigger(field, "required")
+			errors = append(errors, ValidationError{
+				Field:   field,
+				Message: s.options.format("RequiredField", field),
+				Code:    "required",
+			})
+		}
+		return errors
+	}
+	coordinates, nodes := []string{"lat", "lng"}, []int{latNode, lngNode}
+	for i, coordinate := range coordinates {
+		if nodes[i] < 0 {
+			s.trigger(getFieldName(field, coordinate), "required")
+			errors = append(errors, ValidationError{
+				Field:   getFieldName(field, coordinate),
+				Message: s.options.format("RequiredField", getFieldName(field, coordinate)),
+				Code:    "required",
+			})
+		}
+	}
+	if errors != nil {
+		return errors
+	}
+
+	// 4) Validate the ranges and the precision of the coordinates.
+	lat, latErrors := s.validateCoordinate(validations, field, "lat", latNode, 90)
+	lng, lngErrors := s.validateCoordinate(validations, field, "lng", lngNode, 180)
+	if errors = append(latErrors, lngErrors...); errors != nil {
+		return errors
+	}
+
+	// 5) Validate the custom rules.
+	if customErrors := s.validateCustom(validations, field, GeoPoint{Lat: lat, Lng: lng}); customErrors != nil {
+		return customErrors
+	}
+
+	// 6) Update form with the parsed point.
+	setValue(form, validations, &GeoPoint{Lat: lat, Lng: lng})
+
+	// 7) Return errors.
+	return nil
+}
+
+// validateCoordinate validates a coordinate of a geo point, which must be between -limit and limit and have at most
+// the declared precision (decimals).
+func (s *state) validateCoordinate(validations *Validations, field, coordinate string, node int, limit float64) (float64, []error) {
+
+	// 1) Validate the node type.
+	fieldName := getFieldName(field, coordinate)
+	value, invalidFormat := validateFloatType(s.document, node)
+	if invalidFormat || s.strictRejects(validations, node, "float") {
+		return 0, []error{s.formatError(fieldName, node)}
+	}
+	if errors := s.nonFiniteErrors(fieldName, node, *value); errors != nil {
+		return 0, errors
+	}
+	s.recordCoercion(fieldName, node, "float")
+
+	// 2) Validate the range.
+	var errors []error
+	if *value < -limit {
+		s.trigger(fieldName, "min")
+		errors = append(errors, ValidationError{
+			Field:   fieldName,
+			Message: s.options.format("InvalidMinNumber", fieldName, -limit),
+			Code:    "min",
+		})
+	}
+	if *value > limit {
+		s.trigger(fieldName, "max")
+		errors = append(errors, ValidationError{
+			Field:   fieldName,
+			Message: s.options.format("InvalidMaxNumber", fieldName, limit),
+			Code:    "max",
+		})
+	}
+
+	// 3) Validate the precision.
+	if validations.Precision > 0 && decimals(*value) > validations.Precision {
+		s.trigger(field, "precision")
+		errors = append(errors, ValidationError{
+			Field:   fieldName,
+			Message: s.options.format("InvalidPrecision", fieldName, validations.Precision),
+			Code:    "precision",
+		})
+	}
+
+	// 4) Return the coordinate.
+	return *value, errors
+}
+
+// decimals returns the number of decimals of the shortest representation of the value.
+func decimals(value float64) int {
+	_, fraction, found := strings.Cut(strconv.FormatFloat(value, 'f', -1, 64), ".")
+	if !found {
+		return 0
+	}
+	return len(fraction)
+}
@@ -0,0 +1,119 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_Geo(t *testing.T) {
+	type createObject struct {
+		Location *GeoPoint `validations:"type=geo;required=true;precision=6"`
+		Origin   *GeoPoint `validations:"type=geo"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+		wantForm *createObject
+	}{
+		{
+			name:     "test_geo",
+			jsonData: []byte(`{"location": {"lat": 40.416775, "lng": "-3.70379"}, "origin": {}}`),
+			want:     nil,
+			wantForm: &createObject{Location: &GeoPoint{Lat: 40.416775, Lng: -3.70379}},
+		},
+		{
+			name:     "test_geo_missing_coordinate",
+			jsonData: []byte(`{"location": {"lat": 40.4}, "origin": {"lng": 1, "alt": 650}}`),
+			want: []error{
+				ValidationError{Field: "location.lng", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "origin.alt", Message: DefaultMessages["InvalidField"], Code: "unknown_field"},
+				ValidationError{Field: "origin.lat", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+			wantForm: &createObject{},
+		},
+		{
+			name:     "test_geo_required_empty",
+			jsonData: []byte(`{"location": {}}`),
+			want: []error{
+				ValidationError{Field: "location", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+			wantForm: &createObject{},
+		},
+		{
+			name:     "test_geo_ranges_and_precision",
+			jsonData: []byte(`{"location": {"lat": 90.5, "lng": 1.1234567}, "origin": {"lat": 0, "lng": -180.1}}`),
+			want: []error{
+				ValidationError{Field: "location.lat", Message: defaultMessage("InvalidMaxNumber", 90), Code: "max"},
+				ValidationError{Field: "location.lng", Message: defaultMessage("InvalidPrecision", 6), Code: "precision"},
+				ValidationError{Field: "origin.lng", Message: defaultMessage("InvalidMinNumber", -180), Code: "min"},
+			},
+			wantForm: &createObject{},
+		},
+		{
+			name:     "test_geo_wrong_type",
+			jsonData: []byte(`{"location": [40.4, -3.7]}`),
+			want: []error{
+				ValidationError{Field: "location", Message: defaultMessage("InvalidFormat", []any{40.4, -3.7}), Code: "invalid_type", Line: 1, Column: 14},
+			},
+			wantForm: &createObject{},
+		},
+		{
+			name:     "test_geo_null",
+			jsonData: []byte(`{"location": null}`),
+			want: []error{
+				ValidationError{Field: "location", Message: defaultMessage("InvalidFormat", nil), Code: "invalid_type", Line: 1, Column: 14},
+			},
+			wantForm: &createObject{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := Validate(tt.jsonData, form)
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(form, tt.wantForm) {
+				t.Errorf("Validate() form = %+v, want %+v", form, tt.wantForm)
+			}
+		})
+	}
+}
+
+func TestValidate_GeoNullable(t *testing.T) {
+	type createObject struct {
+		Location *GeoPoint `validations:"type=geo;required=true;nullable=true"`
+	}
+	form := new(createObject)
+	if got := Validate([]byte(`{"location": null}`), form); got != nil {
+		t.Errorf("Validate() = %v, want nil", got)
+	}
+	if form.Location != nil {
+		t.Errorf("Validate() form = %+v, want an empty location", form)
+	}
+}
+
+func TestValidate_GeoMissingCoordinateCoverage(t *testing.T) {
+	type createObject struct {
+		Origin *GeoPoint `validations:"type=geo"`
+	}
+	coverage := NewRuleCoverage()
+	Validate([]byte(`{"origin": {"lng": 1}}`), new(createObject), WithRuleCoverage(coverage))
+	if !coverage.triggered["origin.lat:required"] || coverage.triggered["origin:type"] {
+		t.Errorf("Validate() triggered %v, want origin.lat:required", coverage.triggered)
+	}
+}
+
+func TestValidate_GeoRangeCoverage(t *testing.T) {
+	type createObject struct {
+		Origin *GeoPoint `validations:"type=geo"`
+	}
+	coverage := NewRuleCoverage()
+	Validate([]byte(`{"origin": {"lat": -90.5, "lng": 180.5}}`), new(createObject), WithRuleCoverage(coverage))
+	if !coverage.triggered["origin.lat:min"] || !coverage.triggered["origin.lng:max"] || coverage.triggered["origin:type"] {
+		t.Errorf("Validate() triggered %v, want origin.lat:min and origin.lng:max", coverage.triggered)
+	}
+}
@@ -0,0 +1,275 @@
+package jsonValidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// ValidateHeaders validates the headers against a form received and update the form with the parsed data. Each
+// form field is mapped to the canonical header name of its words (XApiKey is read from "X-Api-Key") and the errors
+// are reported with the header names. Headers that are not declared in the form are ignored.
+func ValidateHeaders(h http.Header, form any, opts ...Option) []error {
+
+	// 1) Get form value.
+	formValue, err := formValueOf(form)
+	if err != nil {
+		return []error{err}
+	}
+
+	// 2) Validate the declared headers.
+	o := newOptions(opts)
+	return validateHeaders(h, formValue, getValidations(formValue, o.syntax()), o)
+}
+
+func validateHeaders(h http.Header, formValue reflect.Value, validationsMap map[string]*Validations, o *options) []error {
+
+	// 1) Index the validations by header name.
+	headersValidations := make(map[string]*Validations)
+	for _, validations := range validationsMap {
+		headersValidations[HeaderName(validations.structField)] = validations
+	}
+
+	// 2) Get the values of the declared headers.
+	values := make(map[string][]string)
+	for headerName := range headersValidations {
+		if headerValues := h.Values(headerName); len(headerValues) > 0 {
+			values[headerName] = headerValues
+		}
+	}
+
+	// 3) Validate the values as a json object.
+	o.fromValues = true
+	return validateForm(valuesToJson(values, headersValidations), formValue, headersValidations, o)
+}
+
+// HeaderName returns the canonical header name of a form field name, separating its words with "-"
+// (XApiKey becomes "X-Api-Key" and XRequestID becomes "X-Request-Id").
+func HeaderName(fieldName string) string {
+	runes := []rune(fieldName)
+	var name []rune
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) &&
+			(unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			name = append(name, '-')
+		}
+		name = append(name, r)
+	}
+	return http.CanonicalHeaderKey(string(name))
+}
+
+// ValidatePathParams validates the URL path parameters (e.g. numeric ids or enum-like resource types) against a form
+// received and update the form with the parsed data. The parameters are matched with the form fields like json keys
+// and the parameters that are not declared in the form are ignored. The maps returned by gorilla/mux (mux.Vars) can
+// be used directly, chi and gin/httprouter parameters can be converted with PathParamsFromPairs and PathParamsFromSlice.
+func ValidatePathParams(params map[string]string, form any, opts ...Option) []error {
+
+	// 1) Get form value.
+	formValue, err := formValueOf(form)
+	if err != nil {
+		return []error{err}
+	}
+
+	// 2) Validate the declared parameters.
+	values := make(map[string][]string, len(params))
+	for name, value := range params {
+		values[name] = []string{value}
+	}
+	o := newOptions(opts)
+	return validateValues(values, formValue, getValidations(formValue, o.syntax()), o)
+}
+
+// validateValues validates the string values of the declared fields, ignoring the values that are not declared.
+func validateValues(values map[string][]string, formValue reflect.Value, validationsMap map[string]*Validations, o *options) []error {
+
+	// 1) Keep the values of the declared fields.
+	declaredValues := make(map[string][]string)
+	for name, fieldValues := range values {
+		if _, ok := validationsMap[name]; ok {
+			declaredValues[name] = fieldValues
+		}
+	}
+
+	// 2) Validate the values as a json object.
+	o.fromValues = true
+	return validateForm(valuesToJson(declaredValues, validationsMap), formValue, validationsMap, o)
+}
+
+// PathParamsFromPairs converts parallel keys and values slices into a path parameters map, e.g. the chi route
+// parameters: PathParamsFromPairs(rctx.URLParams.Keys, rctx.URLParams.Values).
+func PathParamsFromPairs(keys, values []string) map[string]string {
+	params := make(map[string]string, len(keys))
+	for i, key := range keys {
+		if i < len(values) {
+			params[key] = values[i]
+		}
+	}
+	return params
+}
+
+// PathParamsFromSlice converts a slice of structs with Key and Value string fields into a path parameters map,
+// e.g. the gin (c.Params) or httprouter parameters. Other values return an empty map.
+func PathParamsFromSlice(params any) map[string]string {
+	result := make(map[string]string)
+	value := reflect.ValueOf(params)
+	if value.Kind() != reflect.Slice || value.Type().Elem().Kind() != reflect.Struct {
+		return result
+	}
+	for i := 0; i < value.Len(); i++ {
+		key, paramValue := value.Index(i).FieldByName("Key"), value.Index(i).FieldByName("Value")
+		if key.Kind() == reflect.String && paramValue.Kind() == reflect.String {
+			result[key.String()] = paramValue.String()
+		}
+	}
+	return result
+}
+
+// ValidateRequest validates a whole request against a form received and update the form with the parsed data. The
+// section of each field is declared with "in=body|query|path|header" (body by default) and the errors are namespaced
+// by section ("body.person.name", "query.page", "path.id" or "header.X-Api-Key"), the sections being validated in the
+// path, query, header and body order. WithMaxBytes and WithIntegrityCheck only apply to the body. The messages are in
+// the locales of the Accept-Language header of the request, unless the options set others. The
+// application/x-www-form-urlencoded bodies are validated like ValidateFormValues and the multipart/form-data bodies
+// like ValidateMultipartForm.
+func ValidateRequest(r *http.Request, pathParams map[string]string, form any, opts ...Option) []error {
+
+	// 1) Get form value, and the locales of the request before the options.
+	formValue, err := formValueOf(form)
+	if err != nil {
+		return []error{err}
+	}
+	opts = append([]Option{WithAcceptLanguage(r.Header.Get("Accept-Language"))}, opts...)
+
+	// 2) Split the validations by section.
+	sections := make(map[string]map[string]*Validations)
+	for fieldName, validations := range getValidations(formValue, newOptions(opts).syntax()) {
+		in := validations.In
+		if in == "" {
+			in = "body"
+		}
+		if sections[in] == nil {
+			sections[in] = make(map[string]*Validations)
+		}
+		sections[in][fieldName] = validations
+	}
+
+	// 3) Validate each section, in the order of the request.
+	var errors []error
+	var body []byte
+	for _, in := range []string{"path", "query", "header", "body"} {
+		validationsMap, ok := sections[in]
+		if !ok {
+			continue
+		}
+		o := newOptions(opts)
+		o.pathPrefix = getFieldName(o.pathPrefix, in)
+		if in != "body" {
+			// The size limit and the integrity check apply to the raw body, not to the values of the other sections.
+			o.maxBytes, o.integrityCheck = 0, nil
+		}
+		switch in {
+		case "body":
+			if mediaType, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "multipart/form-data" {
+				errors = append(errors, validateMultipartBody(r, params["boundary"], formValue, validationsMap, o)...)
+				continue
+			}
+			if body, err = readBody(r.Body, o); err != nil {
+				errors = append(errors, err)
+				continue
+			}
+			if integrityErrors := verifyIntegrity(body, o); integrityErrors != nil {
+				errors = append(errors, integrityErrors...)
+				continue
+			}
+			o.maxBytes, o.integrityCheck = 0, nil
+
+			// The raw body being verified, the url-encoded bodies are converted like ValidateFormValues and the empty
+			// bodies validated as an empty object.
+			jsonData := body
+			if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "application/x-www-form-urlencoded" {
+				values, _ := url.ParseQuery(string(bytes.TrimSpace(body)))
+				jsonData, _ = json.Marshal(formValuesObject(values, formValue.Type(), o.syntax()))
+				o.fromValues = true
+			} else if len(bytes.TrimSpace(body)) == 0 {
+				jsonData = []byte("{}")
+			}
+			errors = append(errors, validateForm(jsonData, formValue, validationsMap, o)...)
+		case "query":
+			errors = append(errors, validateValues(r.URL.Query(), formValue, validationsMap, o)...)
+		case "path":
+			values := make(map[string][]string, len(pathParams))
+			for name, value := range pathParams {
+				values[name] = []string{value}
+			}
+			errors = append(errors, validateValues(values, formValue, validationsMap, o)...)
+		case "header":
+			errors = append(errors, validateHeaders(r.Header, formValue, validationsMap, o)...)
+		}
+	}
+
+	// 4) Audit the validation with the body and return the errors.
+	newOptions(opts).audit(formValue.Type(), body, errors)
+	return errors
+}
+
+// ValidateReader validates the json data read from r (e.g. the body of a request) against a form received and update
+// the form with the parsed data. The json data is parsed as it is read, without buffering the payload first: a syntax
+// error stops the reading at the invalid character and, with WithMaxBytes, r stops being read as soon as the limit is
+// crossed and a PayloadTooLargeError is returned. The bytes read are kept by the parsed document, whose values are
+// decoded only when they are validated. An empty body is validated as an empty object, and the errors reading r are
+// audited like the validation errors.
+func ValidateReader(r io.Reader, form any, opts ...Option) []error {
+
+	// 1) Get form value.
+	formValue, err := formValueOf(form)
+	if err != nil {
+		return []error{err}
+	}
+
+	// 2) Parse the json data as it is read, up to the max bytes, auditing the read errors.
+	o := newOptions(opts)
+	if r == nil {
+		r = strings.NewReader("")
+	}
+	if o.maxBytes > 0 {
+		r = &maxBytesReader{r: r, remaining: o.maxBytes, limit: o.maxBytes}
+	}
+	jsonData, document, err := readDocument(r)
+	if _, isSyntaxErr := err.(*syntaxError); err != nil && !isSyntaxErr {
+		errors := []error{err}
+		o.audit(formValue.Type(), nil, errors)
+		return errors
+	}
+
+	// 3) Verify the integrity of the bytes read, then validate the document (an empty object for an empty body), the
+	// payload audited being the bytes read.
+	errors := verifyIntegrity(jsonData, o)
+	if errors == nil {
+		documentData := jsonData
+		if document != nil {
+			documentData = document.data
+		}
+		o.integrityCheck = nil
+		errors = validateDocument(documentData, document, err, formValue, getValidations(formValue, o.syntax()), o)
+	}
+	o.audit(formValue.Type(), jsonData, errors)
+	return errors
+}
+
+// readBody reads the whole body into memory as it was received, limited by the WithMaxBytes option. A nil body is read
+// as an empty one.
+func readBody(body io.Reader, o *options) ([]byte, error) {
+	if body == nil {
+		return []byte{}, nil
+	}
+	if o.maxBytes > 0 {
+		body = &maxBytesReader{r: body, remaining: o.maxBytes, limit: o.maxBytes}
+	}
+	return io.ReadAll(body)
+}
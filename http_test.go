@@ -0,0 +1,426 @@
+package jsonValidator
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestHeaderName(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"XApiKey", "X-Api-Key"},
+		{"XRequestID", "X-Request-Id"},
+		{"TraceId", "Trace-Id"},
+		{"Authorization", "Authorization"},
+		{"ContentMD5", "Content-Md5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := HeaderName(tt.input); got != tt.want {
+				t.Errorf("HeaderName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateHeaders(t *testing.T) {
+	type createObject struct {
+		XApiKey    *string  `validations:"type=string;required=true;min=8"`
+		XRequestID *int     `validations:"type=int"`
+		Accept     []string `validations:"type=[]string;choices=application/json,text/plain"`
+	}
+	tests := []struct {
+		name     string
+		headers  http.Header
+		want     []error
+		wantForm createObject
+	}{
+		{
+			name: "test_headers",
+			headers: http.Header{
+				"X-Api-Key":    {"secret-key"},
+				"X-Request-Id": {"123"},
+				"Accept":       {"application/json", "text/plain"},
+				"User-Agent":   {"test"},
+			},
+			want: nil,
+			wantForm: createObject{
+				XApiKey:    toStringPointer("secret-key"),
+				XRequestID: toIntPointer(123),
+				Accept:     []string{"application/json", "text/plain"},
+			},
+		},
+		{
+			name: "test_headers_errors",
+			headers: http.Header{
+				"X-Request-Id": {"abc"},
+				"Accept":       {"text/html"},
+			},
+			want: []error{
+				ValidationError{Field: "X-Api-Key", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "X-Request-Id", Message: defaultMessage("InvalidFormat", "abc"), Code: "invalid_type"},
+				ValidationError{Field: "Accept[0]", Message: defaultMessage("InvalidChoice", "text/html", "[application/json text/plain]"), Code: "choice"},
+			},
+			wantForm: createObject{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := ValidateHeaders(tt.headers, form)
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateHeaders() = %#v, want %#v", got, tt.want)
+			}
+			if !reflect.DeepEqual(*form, tt.wantForm) {
+				t.Errorf("ValidateHeaders() = %v, want %v", *form, tt.wantForm)
+			}
+		})
+	}
+}
+
+func TestValidatePathParams(t *testing.T) {
+	type createObject struct {
+		ID           *int    `validations:"type=int;required=true;min=1"`
+		ResourceType *string `validations:"type=string;choices=users,orders"`
+	}
+	tests := []struct {
+		name     string
+		params   map[string]string
+		want     []error
+		wantForm createObject
+	}{
+		{
+			name:     "test_path_params",
+			params:   map[string]string{"iD": "12", "resourceType": "users", "version": "v1"},
+			want:     nil,
+			wantForm: createObject{ID: toIntPointer(12), ResourceType: toStringPointer("users")},
+		},
+		{
+			name:   "test_path_params_errors",
+			params: map[string]string{"iD": "abc", "resourceType": "products"},
+			want: []error{
+				ValidationError{Field: "iD", Message: defaultMessage("InvalidFormat", "abc"), Code: "invalid_type"},
+				ValidationError{Field: "resourceType", Message: defaultMessage("InvalidChoice", "products", "[users orders]"), Code: "choice"},
+			},
+			wantForm: createObject{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := ValidatePathParams(tt.params, form)
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidatePathParams() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(*form, tt.wantForm) {
+				t.Errorf("ValidatePathParams() = %v, want %v", *form, tt.wantForm)
+			}
+		})
+	}
+}
+
+func TestPathParamsAdapters(t *testing.T) {
+	type param struct {
+		Key   string
+		Value string
+	}
+	want := map[string]string{"id": "12", "resourceType": "users"}
+	if got := PathParamsFromPairs([]string{"id", "resourceType"}, []string{"12", "users"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("PathParamsFromPairs() = %v, want %v", got, want)
+	}
+	if got := PathParamsFromSlice([]param{{"id", "12"}, {"resourceType", "users"}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("PathParamsFromSlice() = %v, want %v", got, want)
+	}
+	if got := PathParamsFromSlice("id"); len(got) != 0 {
+		t.Errorf("PathParamsFromSlice() = %v, want an empty map", got)
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string;required=true"`
+	}
+	type createObject struct {
+		Person  *Person `validations:"type=struct;required=true"`
+		Code    *int    `validations:"in=body;type=int"`
+		Page    *int    `validations:"in=query;type=int;min=1"`
+		Id      *int    `validations:"in=path;type=int;required=true"`
+		XApiKey *string `validations:"in=header;type=string;required=true"`
+	}
+	tests := []struct {
+		name       string
+		body       string
+		target     string
+		pathParams map[string]string
+		headers    http.Header
+		want       []error
+		wantForm   createObject
+	}{
+		{
+			name:       "test_request",
+			body:       "{\"person\": {\"name\": \"Daniel\"}, \"code\": 1}",
+			target:     "/users/12?page=2&sort=name",
+			pathParams: map[string]string{"id": "12"},
+			headers:    http.Header{"X-Api-Key": {"secret"}},
+			want:       nil,
+			wantForm: createObject{
+				Person:  &Person{Name: toStringPointer("Daniel")},
+				Code:    toIntPointer(1),
+				Page:    toIntPointer(2),
+				Id:      toIntPointer(12),
+				XApiKey: toStringPointer("secret"),
+			},
+		},
+		{
+			name:       "test_request_errors",
+			body:       "{\"person\": {}, \"page\": 1}",
+			target:     "/users/abc?page=0",
+			pathParams: map[string]string{"id": "abc"},
+			headers:    http.Header{},
+			want: []error{
+				ValidationError{Field: "body.person.name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "body.page", Message: DefaultMessages["InvalidField"], Code: "unknown_field"},
+				ValidationError{Field: "query.page", Message: defaultMessage("InvalidMinNumber", 1), Code: "min"},
+				ValidationError{Field: "path.id", Message: defaultMessage("InvalidFormat", "abc"), Code: "invalid_type"},
+				ValidationError{Field: "header.X-Api-Key", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+			wantForm: createObject{Person: &Person{}},
+		},
+		{
+			name:       "test_request_empty_body",
+			body:       "",
+			target:     "/users/12",
+			pathParams: map[string]string{"id": "12"},
+			headers:    http.Header{"X-Api-Key": {"secret"}},
+			want: []error{
+				ValidationError{Field: "body.person", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+			wantForm: createObject{Id: toIntPointer(12), XApiKey: toStringPointer("secret")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+			r.Header = tt.headers
+			form := new(createObject)
+			got := ValidateRequest(r, tt.pathParams, form)
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateRequest() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(*form, tt.wantForm) {
+				t.Errorf("ValidateRequest() = %v, want %v", *form, tt.wantForm)
+			}
+		})
+	}
+}
+
+func TestValidateRequest_Sections(t *testing.T) {
+	type createObject struct {
+		Code    *int    `validations:"in=body;type=int"`
+		Page    *int    `validations:"in=query;type=int;min=1"`
+		Id      *int    `validations:"in=path;type=int"`
+		XApiKey *string `validations:"in=header;type=string;required=true;max=3"`
+	}
+	readErr := errors.New("connection reset")
+	tests := []struct {
+		name    string
+		body    io.Reader
+		headers http.Header
+		want    []error
+	}{
+		{
+			name:    "test_request_sections_order",
+			body:    strings.NewReader("{\"code\": \"abc\"}"),
+			headers: http.Header{"X-Api-Key": {"a-long-secret-key"}},
+			want: []error{
+				ValidationError{Field: "path.id", Message: defaultMessage("InvalidFormat", "abc"), Code: "invalid_type"},
+				ValidationError{Field: "query.page", Message: defaultMessage("InvalidMinNumber", 1), Code: "min"},
+				ValidationError{Field: "header.X-Api-Key", Message: defaultMessage("InvalidMaxString", 3), Code: "max"},
+				ValidationError{Field: "body.code", Message: defaultMessage("InvalidFormat", "abc"), Code: "invalid_type", Line: 1, Column: 10},
+			},
+		},
+		{
+			name:    "test_request_read_error",
+			body:    iotest.ErrReader(readErr),
+			headers: http.Header{},
+			want: []error{
+				ValidationError{Field: "path.id", Message: defaultMessage("InvalidFormat", "abc"), Code: "invalid_type"},
+				ValidationError{Field: "query.page", Message: defaultMessage("InvalidMinNumber", 1), Code: "min"},
+				ValidationError{Field: "header.X-Api-Key", Message: DefaultMessages["RequiredField"], Code: "required"},
+				readErr,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				r := httptest.NewRequest(http.MethodPost, "/users/abc?page=0", tt.body)
+				r.Header = tt.headers
+				got := ValidateRequest(r, map[string]string{"id": "abc"}, new(createObject), WithMaxBytes(16))
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Fatalf("ValidateRequest() = %v, want %v", got, tt.want)
+				}
+				if seeker, ok := tt.body.(io.Seeker); ok {
+					seeker.Seek(0, io.SeekStart)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateRequest_IntegrityCheck(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string"`
+		Page *int    `validations:"in=query;type=int"`
+	}
+	errSignature := errors.New("invalid signature")
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		signed      string
+		want        []error
+		wantForm    createObject
+	}{
+		{
+			name:     "test_integrity_check_empty_body",
+			body:     "",
+			signed:   "",
+			wantForm: createObject{Page: toIntPointer(2)},
+		},
+		{
+			name:        "test_integrity_check_urlencoded_body",
+			body:        "name=Daniel",
+			contentType: "application/x-www-form-urlencoded",
+			signed:      "name=Daniel",
+			wantForm:    createObject{Name: toStringPointer("Daniel"), Page: toIntPointer(2)},
+		},
+		{
+			name:        "test_integrity_check_urlencoded_body_error",
+			body:        "name=Silva",
+			contentType: "application/x-www-form-urlencoded",
+			signed:      "name=Daniel",
+			want:        []error{IntegrityError{Err: errSignature}},
+			wantForm:    createObject{Page: toIntPointer(2)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signature := sha256.Sum256([]byte(tt.signed))
+			check := func(raw []byte) error {
+				if sha256.Sum256(raw) != signature {
+					return errSignature
+				}
+				return nil
+			}
+			r := httptest.NewRequest(http.MethodPost, "/users?page=2", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", tt.contentType)
+			form := new(createObject)
+			got := ValidateRequest(r, nil, form, WithIntegrityCheck(check))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateRequest() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(*form, tt.wantForm) {
+				t.Errorf("ValidateRequest() = %v, want %v", *form, tt.wantForm)
+			}
+		})
+	}
+}
+
+func TestValidateReader_IntegrityCheck(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string"`
+	}
+	for _, body := range []io.Reader{nil, strings.NewReader("")} {
+		var checked []string
+		check := func(raw []byte) error {
+			checked = append(checked, string(raw))
+			return nil
+		}
+		if got := ValidateReader(body, new(createObject), WithIntegrityCheck(check)); got != nil {
+			t.Errorf("ValidateReader() = %v, want nil", got)
+		}
+		if !reflect.DeepEqual(checked, []string{""}) {
+			t.Errorf("ValidateReader() checked %q, want the empty body", checked)
+		}
+	}
+}
+
+type endlessReader struct{}
+
+func (endlessReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 'a'
+	}
+	return len(p), nil
+}
+
+func TestValidateReader(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string;required=true"`
+	}
+	tests := []struct {
+		name     string
+		body     io.Reader
+		want     []error
+		wantForm createObject
+	}{
+		{
+			name:     "test_reader",
+			body:     strings.NewReader("{\"name\": \"Daniel\"}"),
+			wantForm: createObject{Name: toStringPointer("Daniel")},
+		},
+		{
+			name: "test_reader_empty_body",
+			body: strings.NewReader(""),
+			want: []error{ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"}},
+		},
+		{
+			name: "test_reader_max_bytes",
+			body: io.MultiReader(strings.NewReader("{\"name\": \""), endlessReader{}),
+			want: []error{PayloadTooLargeError{Limit: 64}},
+		},
+		{
+			name: "test_reader_stops_at_syntax_error",
+			body: io.MultiReader(strings.NewReader("{\"name\":\n x"), endlessReader{}),
+			want: []error{ValidationError{Field: "json", Message: defaultMessage("InvalidFormat", "{\"name\":\n x"), Code: "invalid_json", Line: 2, Column: 2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := ValidateReader(tt.body, form, WithMaxBytes(64))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateReader() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(*form, tt.wantForm) {
+				t.Errorf("ValidateReader() = %v, want %v", *form, tt.wantForm)
+			}
+		})
+	}
+}
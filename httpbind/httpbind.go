@@ -0,0 +1,79 @@
+// Package httpbind binds and validates the HTTP requests into jsonValidator forms, so the handlers do not repeat the
+// reading of the body, the validation and the rendering of the errors.
+package httpbind
+
+import (
+	"encoding/json"
+	"github.com/packntrack/jsonValidator"
+	"net/http"
+)
+
+// Option configures a binding.
+type Option func(*binding)
+
+type binding struct {
+	pathParams func(r *http.Request) map[string]string
+	options    []jsonValidator.Option
+}
+
+// WithPathParams reads the path variables of the requests with pathParams, e.g. mux.Vars, or a function converting the
+// chi or httprouter parameters with jsonValidator.PathParamsFromPairs and jsonValidator.PathParamsFromSlice.
+func WithPathParams(pathParams func(r *http.Request) map[string]string) Option {
+	return func(b *binding) {
+		b.pathParams = pathParams
+	}
+}
+
+// WithOptions passes the options to the validation, e.g. jsonValidator.WithMaxBytes.
+func WithOptions(opts ...jsonValidator.Option) Option {
+	return func(b *binding) {
+		b.options = append(b.options, opts...)
+	}
+}
+
+// Bind reads the body of the request, validates it against a form received and update the form with the parsed data.
+// The fields declared with "in=query", "in=path" or "in=header" are merged from the query string, the path variables
+// (with WithPathParams) and the headers, like jsonValidator.ValidateRequest, which namespaces the errors by section
+// ("body.person.name", "query.page").
+func Bind(r *http.Request, form any, opts ...Option) []error {
+
+	// 1) Apply the options.
+	b := new(binding)
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	// 2) Get the path variables and validate the request.
+	var pathParams map[string]string
+	if b.pathParams != nil {
+		pathParams = b.pathParams(r)
+	}
+	return jsonValidator.ValidateRequest(r, pathParams, form, b.options...)
+}
+
+// Handler returns a handler that binds each request into a new form of type T before calling handle. The requests that
+// are not valid are answered with the problem details of their errors (a 422, a 413 when the body is too large or a
+// 400 when its integrity check failed) and do not reach handle. The errors of the body are located by a JSON pointer
+// into the body, and the other ones by the name of their parameter or header.
+func Handler[T any](handle func(w http.ResponseWriter, r *http.Request, form *T), opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// 1) Bind the request.
+		form := new(T)
+		errs := Bind(r, form, opts...)
+		if errs == nil {
+			handle(w, r, form)
+			return
+		}
+
+		// 2) Write the problem details of the errors.
+		b := new(binding)
+		for _, opt := range opts {
+			opt(b)
+		}
+		problem := jsonValidator.NewRequestProblemDetails(errs, b.options...)
+		w.Header().Set("Content-Type", jsonValidator.ProblemDetailsContentType)
+		w.WriteHeader(problem.Status)
+		_ = json.NewEncoder(w).Encode(problem)
+	})
+}
@@ -0,0 +1,135 @@
+package httpbind
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/packntrack/jsonValidator"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type createObject struct {
+	Name *string `validations:"type=string;required=true"`
+	Page *int    `validations:"in=query;type=int;min=1"`
+	Id   *int    `validations:"in=path;type=int;required=true"`
+}
+
+func pathParams(r *http.Request) map[string]string {
+	return map[string]string{"id": strings.TrimPrefix(r.URL.Path, "/users/")}
+}
+
+func TestBind(t *testing.T) {
+	name, page, id := "Daniel", 2, 12
+	tests := []struct {
+		name     string
+		body     string
+		target   string
+		opts     []Option
+		want     []error
+		wantForm createObject
+	}{
+		{
+			name:     "test_bind",
+			body:     "{\"name\": \"Daniel\"}",
+			target:   "/users/12?page=2",
+			opts:     []Option{WithPathParams(pathParams)},
+			wantForm: createObject{Name: &name, Page: &page, Id: &id},
+		},
+		{
+			name:   "test_bind_without_path_params",
+			body:   "{\"name\": \"Daniel\"}",
+			target: "/users/12",
+			want: []error{
+				jsonValidator.ValidationError{Field: "path.id", Message: jsonValidator.DefaultMessages["RequiredField"], Code: "required"},
+			},
+			wantForm: createObject{Name: &name},
+		},
+		{
+			name:     "test_bind_max_bytes",
+			body:     "{\"name\": \"Daniel Silva\"}",
+			target:   "/users/12",
+			opts:     []Option{WithPathParams(pathParams), WithOptions(jsonValidator.WithMaxBytes(10))},
+			want:     []error{jsonValidator.PayloadTooLargeError{Limit: 10}},
+			wantForm: createObject{Id: &id},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+			form := new(createObject)
+			if got := Bind(r, form, tt.opts...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Bind() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(*form, tt.wantForm) {
+				t.Errorf("Bind() form = %v, want %v", *form, tt.wantForm)
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		opts       []Option
+		wantStatus int
+		wantErrors []jsonValidator.ProblemError
+	}{
+		{
+			name:       "test_handler",
+			body:       "{\"name\": \"Daniel\"}",
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "test_handler_errors",
+			body:       "{}",
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErrors: []jsonValidator.ProblemError{
+				{Detail: jsonValidator.DefaultMessages["RequiredField"], Code: "required", Pointer: "#/name"},
+			},
+		},
+		{
+			name:       "test_handler_max_bytes",
+			body:       "{\"name\": \"Daniel Silva\"}",
+			opts:       []Option{WithOptions(jsonValidator.WithMaxBytes(10))},
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantErrors: []jsonValidator.ProblemError{{Detail: jsonValidator.PayloadTooLargeError{Limit: 10}.Error()}},
+		},
+		{
+			name: "test_handler_integrity",
+			body: "{\"name\": \"Daniel\"}",
+			opts: []Option{WithOptions(jsonValidator.WithIntegrityCheck(func(raw []byte) error {
+				return errors.New("invalid signature")
+			}))},
+			wantStatus: http.StatusBadRequest,
+			wantErrors: []jsonValidator.ProblemError{
+				{Detail: jsonValidator.IntegrityError{Err: errors.New("invalid signature")}.Error()},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := Handler(func(w http.ResponseWriter, r *http.Request, form *createObject) {
+				w.WriteHeader(http.StatusCreated)
+			}, append([]Option{WithPathParams(pathParams)}, tt.opts...)...)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/users/12", strings.NewReader(tt.body)))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("ServeHTTP() status = %v, want %v", w.Code, tt.wantStatus)
+			}
+			if tt.wantErrors == nil {
+				return
+			}
+			var problem jsonValidator.ProblemDetails
+			if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("ServeHTTP() body = %s, error = %v", w.Body.String(), err)
+			}
+			if w.Header().Get("Content-Type") != jsonValidator.ProblemDetailsContentType || !reflect.DeepEqual(problem.Errors, tt.wantErrors) {
+				t.Errorf("ServeHTTP() = %v %v, want %v", w.Header().Get("Content-Type"), problem.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package jsonValidator
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// IdempotencyMaxAge is the max age policy of the idempotency keys. It receives the time a key was issued at (the
+// timestamp of the ULID and version 7 UUID keys, the other UUIDs have none) and reports whether the key expired. The
+// keys never expire when it is nil.
+var IdempotencyMaxAge func(issued time.Time) bool
+
+// MaxAge returns an IdempotencyMaxAge policy expiring the keys issued more than maxAge ago.
+func MaxAge(maxAge time.Duration) func(issued time.Time) bool {
+	return func(issued time.Time) bool {
+		return time.Since(issued) > maxAge
+	}
+}
+
+// IdempotencyHeaders is the form of the Idempotency-Key header of the mutating endpoints.
+type IdempotencyHeaders struct {
+	IdempotencyKey *string `validations:"type=string;required=true"`
+}
+
+// crockfordAlphabet is the alphabet of the ULIDs.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ValidateIdempotencyKey validates the required Idempotency-Key header, which must be a UUID or a ULID not expired
+// according to IdempotencyMaxAge, and returns it.
+func ValidateIdempotencyKey(h http.Header, opts ...Option) (string, []error) {
+
+	// 1) Validate the header.
+	o := newOptions(opts)
+	headers := new(IdempotencyHeaders)
+	if errors := ValidateHeaders(h, headers, opts...); errors != nil {
+		return "", errors
+	}
+	key := *headers.IdempotencyKey
+	fieldName := getFieldName(o.pathPrefix, "Idempotency-Key")
+
+	// 2) Check the format and get the issue time of the key.
+	var issued time.Time
+	var timestamped bool
+	switch {
+	case ulidPattern.MatchString(key):
+		var milliseconds int64
+		for _, r := range strings.ToUpper(key[:10]) {
+			milliseconds = milliseconds<<5 | int64(strings.IndexRune(crockfordAlphabet, r))
+		}
+		issued, timestamped = time.UnixMilli(milliseconds), true
+	case uuidPattern.MatchString(key):
+		if key[14] == '7' {
+			timestamp, _ := hex.DecodeString("0000" + key[:8] + key[9:13])
+			var milliseconds int64
+			for _, b := range timestamp {
+				milliseconds = milliseconds<<8 | int64(b)
+			}
+			issued, timestamped = time.UnixMilli(milliseconds), true
+		}
+	default:
+		return "", []error{ValidationError{Field: fieldName, Message: o.format("InvalidIdempotencyKey", fieldName), Code: "format"}}
+	}
+
+	// 3) Apply the max age policy.
+	if timestamped && IdempotencyMaxAge != nil && IdempotencyMaxAge(issued) {
+		return "", []error{ValidationError{Field: fieldName, Message: o.format("ExpiredIdempotencyKey", fieldName), Code: "expired"}}
+	}
+
+	// 4) Return the key.
+	return key, nil
+}
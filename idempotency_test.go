@@ -0,0 +1,79 @@
+package jsonValidator
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestValidateIdempotencyKey(t *testing.T) {
+	defer func() { IdempotencyMaxAge = nil }()
+	tests := []struct {
+		name    string
+		key     string
+		maxAge  func(issued time.Time) bool
+		want    string
+		wantErr []error
+	}{
+		{
+			name: "test_uuid",
+			key:  "4d3f6c1e-2a7b-4c9d-8e1f-0a2b3c4d5e6f",
+			want: "4d3f6c1e-2a7b-4c9d-8e1f-0a2b3c4d5e6f",
+		},
+		{
+			name: "test_ulid",
+			key:  "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			want: "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		},
+		{
+			name:    "test_expired_ulid",
+			key:     "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			maxAge:  MaxAge(24 * time.Hour),
+			wantErr: []error{ValidationError{Field: "Idempotency-Key", Message: DefaultMessages["ExpiredIdempotencyKey"], Code: "expired"}},
+		},
+		{
+			name:    "test_expired_uuid_v7",
+			key:     "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+			maxAge:  MaxAge(24 * time.Hour),
+			wantErr: []error{ValidationError{Field: "Idempotency-Key", Message: DefaultMessages["ExpiredIdempotencyKey"], Code: "expired"}},
+		},
+		{
+			name:   "test_issued_at",
+			key:    "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+			maxAge: func(issued time.Time) bool { return !issued.Equal(time.UnixMilli(0x017f22e279b0)) },
+			want:   "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+		},
+		{
+			name:   "test_uuid_without_timestamp",
+			key:    "4d3f6c1e-2a7b-4c9d-8e1f-0a2b3c4d5e6f",
+			maxAge: MaxAge(24 * time.Hour),
+			want:   "4d3f6c1e-2a7b-4c9d-8e1f-0a2b3c4d5e6f",
+		},
+		{
+			name:    "test_invalid_key",
+			key:     "my-key",
+			wantErr: []error{ValidationError{Field: "Idempotency-Key", Message: DefaultMessages["InvalidIdempotencyKey"], Code: "format"}},
+		},
+		{
+			name:    "test_missing_key",
+			wantErr: []error{ValidationError{Field: "Idempotency-Key", Message: DefaultMessages["RequiredField"], Code: "required"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			IdempotencyMaxAge = tt.maxAge
+			h := http.Header{}
+			if tt.key != "" {
+				h.Set("Idempotency-Key", tt.key)
+			}
+			got, gotErr := ValidateIdempotencyKey(h)
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("ValidateIdempotencyKey() errors = %v, want %v", gotErr, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ValidateIdempotencyKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
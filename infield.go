@@ -0,0 +1,36 @@
+package jsonValidator
+
+import "fmt"
+
+// validateInField checks the value of a received field declared with "inField=field" against the elements of the list
+// received in that field of the same object (e.g. a default warehouse that must be one of the warehouses).
+func (s *state) validateInField(objectNode int, validations *Validations, fieldName string, parent string) []error {
+
+	// 1) Get the value and the list nodes, the values of an invalid type were already reported. The values are compared
+	// as they are bound, so a coerced value (1 into a string field) matches its string ("1").
+	if validations.InField == "" {
+		return nil
+	}
+	valueNode := s.document.member(objectNode, fieldName)
+	listNode := s.document.member(objectNode, validations.InField)
+	if listNode < 0 || s.document.kind(listNode) != kindArray {
+		return nil
+	}
+	if kind := s.document.kind(valueNode); kind != kindString && kind != kindNumber || s.strictRejects(validations, valueNode, validations.Type) {
+		return nil
+	}
+
+	// 2) Look for the value in the list.
+	value := fmt.Sprintf("%v", s.document.value(valueNode))
+	for _, elementNode := range s.document.elements(listNode) {
+		if fmt.Sprintf("%v", s.document.value(elementNode)) == value {
+			return nil
+		}
+	}
+	s.trigger(getFieldName(parent, fieldName), "inField")
+	return []error{ValidationError{
+		Field:   getFieldName(parent, fieldName),
+		Message: s.options.format("MismatchedInField", getFieldName(parent, fieldName), getFieldName(parent, validations.InField)),
+		Code:    "in_field",
+	}}
+}
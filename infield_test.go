@@ -0,0 +1,58 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_InField(t *testing.T) {
+	type createObject struct {
+		Warehouses       *[]string `validations:"type=[]string"`
+		DefaultWarehouse *string   `validations:"type=string;inField=warehouses"`
+		Levels           *[]int    `validations:"type=[]int"`
+		DefaultLevel     *int      `validations:"type=int;inField=levels"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_in_field",
+			jsonData: []byte(`{"warehouses": ["MAD", "BCN"], "defaultWarehouse": "BCN", "levels": [1, 2], "defaultLevel": 2}`),
+			want:     nil,
+		},
+		{
+			name:     "test_in_field_without_list",
+			jsonData: []byte(`{"defaultWarehouse": "BCN", "defaultLevel": 2}`),
+			want:     nil,
+		},
+		{
+			name:     "test_in_field_mismatch",
+			jsonData: []byte(`{"warehouses": ["MAD", "BCN"], "defaultWarehouse": "VLC", "levels": [], "defaultLevel": 2}`),
+			want: []error{
+				ValidationError{Field: "defaultWarehouse", Message: defaultMessage("MismatchedInField", "warehouses"), Code: "in_field"},
+				ValidationError{Field: "defaultLevel", Message: defaultMessage("MismatchedInField", "levels"), Code: "in_field"},
+			},
+		},
+		{
+			name:     "test_in_field_coerced",
+			jsonData: []byte(`{"warehouses": ["1", "2"], "defaultWarehouse": 1, "levels": [1, 2], "defaultLevel": "2"}`),
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package jsonValidator
+
+import "strconv"
+
+// validateNonOverlapping checks that the intervals of the elements of a []struct field, from their start member to their
+// end member ("nonOverlapping=start:end"), do not overlap. The intervals are half-open, so an interval may start where
+// the previous one ends. The elements without both members or with members of other types are left out, and each
+// overlap is reported on the later element of the pair.
+func (s *state) validateNonOverlapping(validations *Validations, valueList []int, parent string) []error {
+
+	// 1) Initialize the errors list.
+	var errors []error
+	if validations.IntervalStart == "" || validations.IntervalEnd == "" {
+		return nil
+	}
+
+	// 2) Compare each interval with the previous ones.
+	for j, element := range valueList {
+		start, end := s.document.member(element, validations.IntervalStart), s.document.member(element, validations.IntervalEnd)
+		for i := 0; i < j; i++ {
+			previousStart := s.document.member(valueList[i], validations.IntervalStart)
+			previousEnd := s.document.member(valueList[i], validations.IntervalEnd)
+			startsBefore, ok := s.compareNodes(start, previousEnd)
+			if !ok {
+				continue
+			}
+			endsAfter, ok := s.compareNodes(end, previousStart)
+			if !ok || startsBefore >= 0 || endsAfter <= 0 {
+				continue
+			}
+			s.trigger(parent, "nonOverlapping")
+			errors = append(errors, ValidationError{
+				Field:   parent + "[" + strconv.Itoa(j) + "]",
+				Message: s.options.format("OverlappingInterval", parent+"["+strconv.Itoa(j)+"]", parent+"["+strconv.Itoa(i)+"]"),
+				Code:    "overlap",
+			})
+			break
+		}
+	}
+
+	// 3) Return the errors.
+	return errors
+}
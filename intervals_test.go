@@ -0,0 +1,58 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_NonOverlapping(t *testing.T) {
+	type Window struct {
+		Start *string `validations:"type=string"`
+		End   *string `validations:"type=string"`
+	}
+	type Tier struct {
+		From *int `validations:"type=int"`
+		To   *int `validations:"type=int"`
+	}
+	type createObject struct {
+		Windows []Window `validations:"type=[]struct;nonOverlapping=start:end"`
+		Tiers   []Tier   `validations:"type=[]struct;nonOverlapping=from:to"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name: "test_non_overlapping",
+			jsonData: []byte(`{"windows": [{"start": "2024-01-01T09:00:00Z", "end": "2024-01-01T10:00:00Z"},
+				{"start": "2024-01-01T10:00:00Z", "end": "2024-01-01T11:00:00Z"}, {"start": "2024-01-01T08:00:00Z"}],
+				"tiers": [{"from": 10, "to": 20}, {"from": 0, "to": 10}]}`),
+			want: nil,
+		},
+		{
+			name: "test_non_overlapping_overlap",
+			jsonData: []byte(`{"windows": [{"start": "2024-01-01T09:00:00Z", "end": "2024-01-01T10:00:00Z"},
+				{"start": "2024-01-01T11:00:00Z", "end": "2024-01-01T12:00:00Z"}, {"start": "2024-01-01T09:30:00Z", "end": "2024-01-01T09:45:00Z"}],
+				"tiers": [{"from": 0, "to": 10}, {"from": 5, "to": 15}, {"from": 15, "to": 20}]}`),
+			want: []error{
+				ValidationError{Field: "windows[2]", Message: defaultMessage("OverlappingInterval", "windows[0]"), Code: "overlap"},
+				ValidationError{Field: "tiers[1]", Message: defaultMessage("OverlappingInterval", "tiers[0]"), Code: "overlap"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
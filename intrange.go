@@ -0,0 +1,60 @@
+package jsonValidator
+
+import (
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// intBounds returns the range of the integers bound into the type (the platform int when intType is nil).
+func intBounds(intType reflect.Type) (*big.Int, *big.Int) {
+	bits, signed := strconv.IntSize, true
+	if intType != nil {
+		bits = intType.Bits()
+		switch intType.Kind() {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			signed = false
+		}
+	}
+	one := big.NewInt(1)
+	if signed {
+		limit := new(big.Int).Lsh(one, uint(bits-1))
+		return new(big.Int).Neg(limit), limit.Sub(limit, one)
+	}
+	limit := new(big.Int).Lsh(one, uint(bits))
+	return big.NewInt(0), limit.Sub(limit, one)
+}
+
+// intOutOfRange reports whether the node holds an integer, as a json number or a numeric string, that does not fit
+// the integer type of the field (the platform int when intType is nil).
+func (s *state) intOutOfRange(node int, intType reflect.Type) bool {
+
+	// 1) Get the integer held by the node.
+	var raw string
+	switch s.document.kind(node) {
+	case kindString:
+		raw = s.document.stringValue(node)
+	case kindNumber:
+		raw = string(s.document.raw(node))
+	default:
+		return false
+	}
+	number, ok := new(big.Float).SetPrec(256).SetString(raw)
+	if !ok || number.IsInf() || !number.IsInt() {
+		return false
+	}
+	value, _ := number.Int(nil)
+
+	// 2) Compare it with the bounds of the type.
+	minimum, maximum := intBounds(intType)
+	return value.Cmp(minimum) < 0 || value.Cmp(maximum) > 0
+}
+
+// outOfRangeError returns the OutOfRange error of an integer that does not fit the integer type of the field.
+func (s *state) outOfRangeError(fieldName string, fieldNode int, intType reflect.Type) ValidationError {
+	minimum, maximum := intBounds(intType)
+	validationError := s.formatError(fieldName, fieldNode)
+	validationError.Message = s.options.format("OutOfRange", fieldName, minimum, maximum)
+	validationError.Code = "out_of_range"
+	return validationError
+}
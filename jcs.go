@@ -0,0 +1,141 @@
+package jsonValidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// canonicalJSON rewrites json data following the JSON Canonicalization Scheme (RFC 8785): the members of every object
+// are sorted by the UTF-16 code units of their keys, the numbers are written like ECMAScript writes the IEEE 754
+// doubles (1e+21, 0.000001, 1e-7), the strings only escape the quote, the backslash and the control characters, and
+// there is no whitespace.
+func canonicalJSON(jsonData []byte) ([]byte, error) {
+
+	// 1) Decode the json data, keeping the numbers as they are written.
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	// 2) Encode it back canonically.
+	var buffer bytes.Buffer
+	if err := writeCanonical(&buffer, value); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func writeCanonical(buffer *bytes.Buffer, value any) error {
+	switch value := value.(type) {
+	case nil:
+		buffer.WriteString("null")
+	case bool:
+		buffer.WriteString(strconv.FormatBool(value))
+	case json.Number:
+		number, err := canonicalNumber(value)
+		if err != nil {
+			return err
+		}
+		buffer.WriteString(number)
+	case string:
+		writeCanonicalString(buffer, value)
+	case []any:
+		buffer.WriteByte('[')
+		for i, element := range value {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			if err := writeCanonical(buffer, element); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte(']')
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool { return lessUTF16(keys[i], keys[j]) })
+		buffer.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			writeCanonicalString(buffer, key)
+			buffer.WriteByte(':')
+			if err := writeCanonical(buffer, value[key]); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte('}')
+	}
+	return nil
+}
+
+// canonicalNumber writes a number like the ECMAScript Number.prototype.toString of its IEEE 754 double: the shortest
+// digits that round trip, in decimal notation from 1e-6 to 1e21 and in exponent notation otherwise.
+func canonicalNumber(number json.Number) (string, error) {
+	value, err := strconv.ParseFloat(string(number), 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return "", fmt.Errorf("jsonValidator: the number %v cannot be canonicalized", number)
+	}
+	if value == 0 {
+		return "0", nil
+	}
+	if abs := math.Abs(value); abs >= 1e-6 && abs < 1e21 {
+		return strconv.FormatFloat(value, 'f', -1, 64), nil
+	}
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(value, 'e', -1, 64), "e")
+	sign, digits := exponent[:1], strings.TrimLeft(exponent[1:], "0")
+	return mantissa + "e" + sign + digits, nil
+}
+
+// writeCanonicalString writes a json string escaping only the quote, the backslash and the control characters, with
+// their short escapes when they have one.
+func writeCanonicalString(buffer *bytes.Buffer, value string) {
+	buffer.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '"':
+			buffer.WriteString(`\"`)
+		case '\\':
+			buffer.WriteString(`\\`)
+		case '\b':
+			buffer.WriteString(`\b`)
+		case '\f':
+			buffer.WriteString(`\f`)
+		case '\n':
+			buffer.WriteString(`\n`)
+		case '\r':
+			buffer.WriteString(`\r`)
+		case '\t':
+			buffer.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buffer, `\u%04x`, r)
+			} else {
+				buffer.WriteRune(r)
+			}
+		}
+	}
+	buffer.WriteByte('"')
+}
+
+// lessUTF16 compares two keys by their UTF-16 code units, as RFC 8785 sorts the members of the objects.
+func lessUTF16(a, b string) bool {
+	unitsA, unitsB := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+	for i := 0; i < len(unitsA) && i < len(unitsB); i++ {
+		if unitsA[i] != unitsB[i] {
+			return unitsA[i] < unitsB[i]
+		}
+	}
+	return len(unitsA) < len(unitsB)
+}
@@ -0,0 +1,66 @@
+package jsonValidator
+
+import (
+	"testing"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		jsonData string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "test_sorted_keys",
+			jsonData: "{\"b\": {\"z\": 1, \"a\": [3, {\"y\": 2, \"x\": 1}]}, \"a\": null, \"\\u20ac\": true, \"\\ud83d\\ude00\": false, \"\\ufb33\": 0}",
+			want:     "{\"a\":null,\"b\":{\"a\":[3,{\"x\":1,\"y\":2}],\"z\":1},\"\u20ac\":true,\"\U0001F600\":false,\"\ufb33\":0}",
+		},
+		{
+			name:     "test_numbers",
+			jsonData: "[1.0, -0, 1E+30, 1e21, 999999999999999999999, 0.000001, 1e-7, 123.4560, -1.5e-10, 4.50, 9007199254740993]",
+			want:     "[1,0,1e+30,1e+21,1e+21,0.000001,1e-7,123.456,-1.5e-10,4.5,9007199254740992]",
+		},
+		{
+			name:     "test_strings",
+			jsonData: "[\"<a href='x'>&amp;</a>\", \"line\\nbreak\\ttab\\u0001\\u001f\", \"quote\\\" backslash\\\\ slash\\/\", \"\\u2028\"]",
+			want:     "[\"<a href='x'>&amp;</a>\",\"line\\nbreak\\ttab\\u0001\\u001f\",\"quote\\\" backslash\\\\ slash/\",\"\u2028\"]",
+		},
+		{
+			name:     "test_invalid_number",
+			jsonData: "[1e400]",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := canonicalJSON([]byte(tt.jsonData))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("canonicalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("canonicalJSON() = %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalize_CanonicalJSON(t *testing.T) {
+	type createObject struct {
+		Name     *string   `validations:"type=string"`
+		Price    *float64  `validations:"type=float"`
+		Total    *Money    `validations:"type=money"`
+		Location *GeoPoint `validations:"type=geo"`
+	}
+	form := new(createObject)
+	if errs := Validate([]byte("{\"total\": \"1.50 EUR\", \"name\": \"<Tom & Jerry>\", \"price\": 1e21, \"location\": {\"lng\": -3.7, \"lat\": 40.4}}"), form); errs != nil {
+		t.Fatalf("Validate() = %v, want nil", errs)
+	}
+	want := "{\"location\":{\"lat\":40.4,\"lng\":-3.7},\"name\":\"<Tom & Jerry>\",\"price\":1e+21,\"total\":{\"amount\":1.5,\"currency\":\"EUR\"}}"
+	if got, err := Canonicalize(form, WithCanonicalJSON()); err != nil || string(got) != want {
+		t.Errorf("Canonicalize() = %s, %v, want %v", got, err, want)
+	}
+	if got, err := Marshal(form, WithCanonicalJSON()); err != nil || string(got) != want {
+		t.Errorf("Marshal() = %s, %v, want %v", got, err, want)
+	}
+}
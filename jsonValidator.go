@@ -2,10 +2,12 @@ package jsonValidator
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	"reflect"
+	"regexp"
 	"strings"
 	"unicode"
 )
@@ -13,36 +15,290 @@ import (
 type ValidationError struct {
 	Field   string
 	Message string
+	// Code is the machine-readable kind of the error, which does not depend on the messages: the name of the rule
+	// ("required", "min", "max", "choice", "pattern", "format"...), "invalid_type" for the values of another type,
+	// "unknown_field", "invalid_json", or the name of the custom validation or the list rule.
+	Code string
+	// Line and Column locate the error in the json data (both start at 1). They are set for syntax errors and
+	// invalid formats, and are zero otherwise.
+	Line   int
+	Column int
 }
 
 func (vr ValidationError) Error() string {
 	return fmt.Sprintf("Field %s: %s", vr.Field, vr.Message)
 }
 
+// MarshalJSON encodes the error as {"field":"name","code":"required","message":"..."}, with the line and column when
+// they are set.
+func (vr ValidationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Field   string `json:"field"`
+		Code    string `json:"code,omitempty"`
+		Message string `json:"message"`
+		Line    int    `json:"line,omitempty"`
+		Column  int    `json:"column,omitempty"`
+	}{vr.Field, vr.Code, vr.Message, vr.Line, vr.Column})
+}
+
+// ValidationErrors is the list of errors returned by a validation.
+type ValidationErrors []error
+
+func (ve ValidationErrors) Error() string {
+	messages := make([]string, len(ve))
+	for i, err := range ve {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// MarshalJSON encodes the errors as {"errors":[...]}, so they can be written as the response body. The errors that
+// are not a ValidationError (an IntegrityError, a PayloadTooLargeError...) only have their message.
+func (ve ValidationErrors) MarshalJSON() ([]byte, error) {
+	list := make([]any, len(ve))
+	for i, err := range ve {
+		var validationError ValidationError
+		if errors.As(err, &validationError) {
+			list[i] = validationError
+		} else {
+			list[i] = struct {
+				Message string `json:"message"`
+			}{err.Error()}
+		}
+	}
+	return json.Marshal(struct {
+		Errors []any `json:"errors"`
+	}{list})
+}
+
+// IntegrityError is returned when the integrity check of the raw body fails.
+type IntegrityError struct {
+	Err error
+}
+
+func (ie IntegrityError) Error() string {
+	return fmt.Sprintf("Integrity check failed: %v", ie.Err)
+}
+
+func (ie IntegrityError) Unwrap() error {
+	return ie.Err
+}
+
+// Coercion describes a lenient conversion of a received value into the declared type of the field.
+type Coercion struct {
+	Field string
+	From  string
+	To    string
+	Value any
+}
+
+func (c Coercion) String() string {
+	return fmt.Sprintf("%s: %s %#v coerced to %s", c.Field, c.From, c.Value, c.To)
+}
+
+// PayloadTooLargeError is returned when the body is bigger than the limit set with WithMaxBytes.
+type PayloadTooLargeError struct {
+	Limit int64
+}
+
+func (pe PayloadTooLargeError) Error() string {
+	return fmt.Sprintf("Payload must not have more than %v bytes", pe.Limit)
+}
+
 type Validations struct {
-	Type     string
-	Required bool
-	Min      float64
-	Max      float64
-	Choices  []any
+	Type              string
+	Required          bool
+	RequiredIf        *Condition
+	RequiredUnless    *Condition
+	RequiredWith      []string
+	Strict            bool
+	Nullable          bool
+	DisallowEmptyList bool
+	DisallowBidi      bool
+	NoEmoji           bool
+	NoMarkup          bool
+	Min               float64
+	Max               float64
+	Len               int
+	MinKeys           int
+	MaxKeys           int
+	MinDigits         int
+	MaxDigits         int
+	Values            *Validations
+	Choices           []any
+	ChoiceLabels      []string
+	ChoicesFunc       string
+	Excluded          []any
+	Pattern           *regexp.Regexp
+	Normalize         string
+	Custom            []string
+	Checksum          string
+	ChecksumWeights   []int
+	Format            string
+	Prefixes          []string
+	Country           string
+	InField           string
+	Fields            []string
+	Operators         []string
+	Precision         int
+	Epsilon           float64
+	Scale             int
+	Currencies        []string
+	ListRule          string
+	SumField          string
+	SumMin            float64
+	SumMax            float64
+	AvgField          string
+	AvgMin            float64
+	AvgMax            float64
+	Sorted            string
+	SortedBy          string
+	IntervalStart     string
+	IntervalEnd       string
+	Impl              []string
+	Discriminator     string
+	MaxBytes          int64
+	MediaTypes        []string
+	In                string
+	Default           string
+	DefaultField      string
+	DefaultTransform  string
+	ZeroIfAbsent      bool
+	Description       string
+	Example           string
+
+	// structField is the name of the form field the validations were declared on.
+	structField string
+
+	// invalidPattern is the "pattern=" rule that is not a valid regular expression, reported as a ConfigError.
+	invalidPattern string
+
+	// invalidDefault is the derived "default=" rule that cannot be parsed with the separators of the tags, reported as
+	// a ConfigError.
+	invalidDefault string
+
+	// flagged is set when some rules of the field are guarded by a flag.
+	flagged bool
+
+	// offset is the offset of the field in the form, used to set it when unsafeSet is set (see setValue).
+	offset    uintptr
+	unsafeSet bool
+
+	// intType is the type of the sized integer fields (e.g. int32 for a *int32 or []int32 field), nil for int.
+	intType reflect.Type
+
+	// nullField is set when the field is a pointer to the bound type (e.g. **string for type=string), which tells a
+	// null value (a pointer to nil) apart from an absent one (nil).
+	nullField bool
+
+	// valueField is set when the field is of the bound type instead of a pointer to it (e.g. string for type=string).
+	valueField bool
+
+	// index is the index path of a field promoted from an embedded struct, nil for the fields declared on the form.
+	// indirect is set when it is promoted through an embedded pointer, so it has no fixed offset in the form.
+	index    []int
+	indirect bool
 }
 
+// DefaultMessages holds the English messages of the validation errors. It must not be written directly, which races
+// with the validations reading it: the messages are changed with RegisterMessage and ImportMessages, which are safe to
+// call while validating, or per validation with WithMessages.
 var DefaultMessages = map[string]string{
-	"InvalidField":     "This field is invalid.",
-	"InvalidFormat":    "This field has an invalid format (%v).",
-	"InvalidMinString": "This field must have at least %v characters.",
-	"InvalidMaxString": "This field must not have more than %v characters.",
-	"InvalidMinNumber": "This field must be bigger than %v.",
-	"InvalidMaxNumber": "This field must be smaller than %v.",
-	"InvalidMinList":   "This field must have at least %v elements.",
-	"InvalidMaxList":   "This field must not have more than %v elements.",
-	"RequiredField":    "This field is required.",
-	"InvalidChoice":    "This field has an invalid choice (%v). The valid choices are (%v)",
+	"InvalidField":             "This field is invalid.",
+	"InvalidFormat":            "This field has an invalid format ({value}).",
+	"InvalidMinString":         "This field must have at least {min} characters.",
+	"InvalidMaxString":         "This field must not have more than {max} characters.",
+	"InvalidMinNumber":         "This field must be bigger than {min}.",
+	"InvalidMaxNumber":         "This field must be smaller than {max}.",
+	"InvalidMinList":           "This field must have at least {min} elements.",
+	"InvalidMaxList":           "This field must not have more than {max} elements.",
+	"InvalidLenString":         "This field must have exactly {len} characters.",
+	"InvalidLenList":           "This field must have exactly {len} elements.",
+	"RequiredField":            "This field is required.",
+	"InvalidMinKeys":           "This field must have at least {min} keys.",
+	"InvalidMaxKeys":           "This field must not have more than {max} keys.",
+	"InvalidDepth":             "This field must not be nested more than {max} levels deep.",
+	"InvalidMinSum":            "The sum of ({member}) of this field must be at least {min}.",
+	"InvalidMaxSum":            "The sum of ({member}) of this field must not be more than {max}.",
+	"InvalidMinAverage":        "The average of ({member}) of this field must be at least {min}.",
+	"InvalidMaxAverage":        "The average of ({member}) of this field must not be more than {max}.",
+	"EmptyList":                "This field must not be empty.",
+	"UnsortedList":             "This element is not in {order} order.",
+	"OverlappingInterval":      "This element overlaps the interval of ({other}).",
+	"InvalidPattern":           "This field does not match the pattern ({pattern}).",
+	"InvalidEmail":             "This field must be a valid email address.",
+	"InvalidUrl":               "This field must be a valid URL.",
+	"InvalidUuid":              "This field must be a valid UUID.",
+	"InvalidUlid":              "This field must be a valid ULID.",
+	"InvalidKsuid":             "This field must be a valid KSUID.",
+	"InvalidBase58":            "This field must be a valid base58 string.",
+	"InvalidBech32":            "This field must be a valid bech32 string.",
+	"InvalidPrefix":            "This field must start with one of the prefixes ({prefixes}).",
+	"InvalidDigits":            "This field must only contain digits.",
+	"InvalidMinDigits":         "This field must have at least {min} digits.",
+	"InvalidMaxDigits":         "This field must not have more than {max} digits.",
+	"InvalidChecksum":          "This field has an invalid check digit.",
+	"InvalidSubdivision":       "This field must be a valid ISO 3166-2 subdivision code.",
+	"MismatchedSubdivision":    "This field must be a subdivision of the country ({country}).",
+	"MismatchedInField":        "This field must be one of the values of ({list}).",
+	"InvalidSortExpression":    "This field has an invalid sort expression ({value}).",
+	"InvalidFilterExpression":  "This field has an invalid filter expression ({value}).",
+	"InvalidIdempotencyKey":    "This field must be a UUID or a ULID.",
+	"ExpiredIdempotencyKey":    "This idempotency key has expired.",
+	"UnknownField":             "This field does not exist.",
+	"UnknownFieldSuggestion":   "This field does not exist. Did you mean ({suggestion})?",
+	"InvalidEmoji":             "This field must not contain emoji or symbols.",
+	"InvalidBidi":              "This field must not contain bidirectional control characters.",
+	"InvalidMarkup":            "This field must not contain HTML markup or scripts.",
+	"InvalidNormalization":     "This field has an invalid {normalizer} value ({value}).",
+	"InvalidPrecision":         "This field must not have more than {precision} decimals.",
+	"NonFiniteNumber":          "This field must be a finite number ({value}).",
+	"OutOfRange":               "This field must be an integer between {min} and {max}.",
+	"InvalidCurrency":          "This field has an invalid currency ({value}).",
+	"InvalidDatetime":          "This field has an invalid datetime ({value}). The expected format is ({format})",
+	"InvalidChoice":            "This field has an invalid choice ({value}). The valid choices are ({choices})",
+	"InvalidFileSize":          "This file must not have more than {max} bytes.",
+	"InvalidDataUri":           "This field must be a valid data URI.",
+	"InvalidMediaType":         "This field has an invalid media type ({value}). The valid media types are ({choices})",
+	"InvalidChoiceWithoutList": "This field has an invalid choice ({value}).",
+	"ExcludedValue":            "This field has a forbidden value ({value}).",
+	"TruncatedChoices":         "{choices} and {count} more",
+	"InvalidVersion":           "This version is invalid ({value}). The valid versions are ({choices})",
+}
+
+// ListRule validates all the bound elements of a []struct field at once (e.g. percentages that must sum to 100).
+// It receives the bound slice and returns the error messages keyed by the element index. Use a negative index
+// for errors that concern the list as a whole.
+type ListRule func(list any) map[int]string
+
+// ListRules holds the list rules available to the "listRule=" validation, indexed by name.
+var ListRules = map[string]ListRule{}
+
+// RegisterListRule registers a list rule under the given name so it can be used as "listRule=name".
+func RegisterListRule(name string, rule ListRule) {
+	ListRules[name] = rule
 }
 
+// Implementations holds the concrete types available to the "impl=" validation of the interface fields, indexed by name.
+var Implementations = map[string]reflect.Type{}
+
+// RegisterImplementation registers the concrete type of impl (a struct or a pointer to a struct) under the given name
+// so it can be used as "impl=name" on the interface fields.
+func RegisterImplementation(name string, impl any) {
+	Implementations[name] = structType(reflect.TypeOf(impl))
+}
+
+// DefaultDiscriminator is the json field holding the name of the concrete type of the interface fields.
+var DefaultDiscriminator = "type"
+
+// DefaultMaxDepth is the maximum nesting of the objects below the root of the json data, which bounds the validation
+// of the recursive forms (e.g. a Category with Children []Category) against hostile payloads.
+var DefaultMaxDepth = 32
+
 var DefaultTagName = "validations"
 var DefaultSeparator = ";"
 var DefaultChoicesSeparator = ","
+var DefaultChoiceLabelSeparator = ":"
 
 func TitleCase(str string) string {
 	return cases.Title(language.English, cases.NoLower).String(str)
@@ -65,66 +321,218 @@ func LowerCase(str string) string {
 }
 
 // Validate validates the json data against a form received and update the form with the parsed data.
-func Validate(jsonData []byte, form any) []error {
+func Validate(jsonData []byte, form any, opts ...Option) []error {
+	return validate(jsonData, form, newOptions(opts))
+}
+
+// ValidateInto validates the json data against a new form of type T and returns it populated with the parsed data, or
+// the errors when the json data is not valid.
+func ValidateInto[T any](jsonData []byte, opts ...Option) (*T, []error) {
+	form := new(T)
+	if errors := Validate(jsonData, form, opts...); errors != nil {
+		return nil, errors
+	}
+	return form, nil
+}
+
+func validate(jsonData []byte, form any, o *options) []error {
 
 	// 1) Get form value.
-	formValue := reflect.ValueOf(form).Elem()
+	formValue, err := formValueOf(form)
+	if err != nil {
+		return []error{err}
+	}
 
-	// 2) Get all the validations from the form.
-	validationsMap := getValidations(formValue)
+	// 2) Get all the validations from the form and validate the json data against them.
+	errors := validateForm(jsonData, formValue, getValidations(formValue, o.syntax()), o)
 
-	// 3) Validate JSON data.
-	errors := validateJsonData(jsonData, formValue, validationsMap, "")
+	// 3) Audit the validation.
+	o.audit(formValue.Type(), jsonData, errors)
+	return errors
+}
+
+func validateForm(jsonData []byte, formValue reflect.Value, validationsMap map[string]*Validations, o *options) []error {
+
+	// 1) Check the size of the raw body.
+	if o.maxBytes > 0 && int64(len(jsonData)) > o.maxBytes {
+		return []error{PayloadTooLargeError{Limit: o.maxBytes}}
+	}
+
+	// 2) Parse the json data into a document and validate it.
+	document, err := parseDocument(jsonData)
+	return validateDocument(jsonData, document, err, formValue, validationsMap, o)
+}
+
+// validateDocument verifies the integrity of the raw body and validates the document parsed from it, err being the
+// error parsing the document.
+func validateDocument(jsonData []byte, document *document, err error, formValue reflect.Value, validationsMap map[string]*Validations, o *options) []error {
 
-	// 4) Return the errors.
+	// 1) Verify the integrity of the raw body.
+	if errors := verifyIntegrity(jsonData, o); errors != nil {
+		return errors
+	}
+
+	// 2) Validate JSON data.
+	s := &state{options: o}
+	errors := s.validateJsonData(jsonData, document, err, formValue, validationsMap, o.pathPrefix)
+
+	// 3) Return the errors.
 	return errors
 }
 
-func validateJsonData(jsonData []byte, form reflect.Value, validationsMap map[string]*Validations, parent string) []error {
+// verifyIntegrity verifies the raw body with the WithIntegrityCheck option, returning the IntegrityError if it fails.
+// The callers converting the body (e.g. an empty or url-encoded body) verify the bytes received before converting them.
+func verifyIntegrity(raw []byte, o *options) []error {
+	if o.integrityCheck != nil {
+		if err := o.integrityCheck(raw); err != nil {
+			return []error{IntegrityError{Err: err}}
+		}
+	}
+	return nil
+}
 
-	// 1) Initialize errors list.
-	var errors []error
+// DryRun validates the json data against a copy of the form, leaving the form untouched, and returns the errors
+// together with every lenient coercion that would have been performed.
+func DryRun(jsonData []byte, form any, opts ...Option) ([]error, []Coercion) {
 
-	// 2) Decode the json data into a decodedJson map.
-	var decodedJson map[string]any
-	err := json.Unmarshal(jsonData, &decodedJson)
+	// 1) Get form value.
+	formValue, err := formValueOf(form)
 	if err != nil {
-		errors = append(errors, ValidationError{
-			Field:   "json",
-			Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], string(jsonData)),
-		})
-		return errors
+		return []error{err}, nil
 	}
 
-	// 3) Iterate over each key in the decodeJson map.
-	for fieldName, fieldValue := range decodedJson {
+	// 2) Collect the coercions while validating a new instance of the form.
+	var coercions []Coercion
+	opts = append(opts, WithCoercionReport(func(c Coercion) {
+		coercions = append(coercions, c)
+	}))
+	errors := Validate(jsonData, reflect.New(formValue.Type()).Interface(), opts...)
+
+	// 3) Return the errors and the coercions.
+	return errors, coercions
+}
+
+// state holds the state of a single validation.
+type state struct {
+	options   *options
+	document  *document
+	coercions []Coercion
+
+	// depth is the nesting of the object being validated below the root.
+	depth int
+}
+
+func (s *state) validateJsonData(jsonData []byte, document *document, err error, form reflect.Value, validationsMap map[string]*Validations, parent string) []error {
 
-		// 3.1) Get the validations for the given fieldName.
+	// 1) Check the document parsed from the json data.
+	if err != nil || (document.kind(0) != kindObject && document.kind(0) != kindNull) {
+		fieldName := "json"
+		if parent != "" {
+			fieldName = parent
+		}
+		validationError := ValidationError{
+			Field:   fieldName,
+			Message: s.options.format("InvalidFormat", fieldName, string(jsonData)),
+			Code:    "invalid_json",
+		}
+		if err == nil {
+			validationError.Code = "invalid_type"
+		}
+		if syntaxErr, ok := err.(*syntaxError); ok {
+			validationError.Line, validationError.Column = position(jsonData, syntaxErr.offset)
+		}
+		return []error{validationError}
+	}
+	s.document = document
+	if s.options.offsets != nil {
+		s.options.offsets.document = document
+		s.options.offsets.prefix = parent
+	}
+
+	// 2) Validate the root object.
+	return s.validateObject(0, form, validationsMap, parent)
+}
+
+func (s *state) validateObject(objectNode int, form reflect.Value, validationsMap map[string]*Validations, parent string) []error {
+
+	// 1) Reject the objects nested deeper than the max depth, without validating their members.
+	if maxDepth := s.options.depth(); s.depth > maxDepth {
+		return []error{ValidationError{
+			Field:   parent,
+			Message: s.options.format("InvalidDepth", parent, maxDepth),
+			Code:    "max_depth",
+		}}
+	}
+	s.depth++
+	defer func() { s.depth-- }()
+
+	// 2) Initialize errors list and the received fields, and apply the rules of the schema.
+	var errors []error
+	received := make(map[string]bool)
+	validationsMap = s.withRules(form, validationsMap, parent)
+
+	// 3) Iterate over each member of the object node.
+	for keyNode := s.document.nodes[objectNode].first; keyNode != 0; keyNode = s.document.nodes[keyNode].next {
+		fieldName := s.document.stringValue(keyNode)
+
+		// 3.1) Get the validations for the given fieldName, the unknown fields are ignored when allowed.
 		validations, ok := validationsMap[fieldName]
+		if !ok && s.options.allowUnknownFields {
+			continue
+		}
 		if !ok {
 			errors = append(errors, ValidationError{
 				Field:   getFieldName(parent, fieldName),
-				Message: DefaultMessages["InvalidField"],
+				Message: s.options.format("InvalidField", getFieldName(parent, fieldName)),
+				Code:    "unknown_field",
 			})
 			continue
 		}
 
-		// 3.2) Update the required bool to false since we have the field present.
-		validations.Required = false
+		// 3.2) Record the field as received, the shared validations are never updated.
+		received[fieldName] = true
+
+		// 3.3) The null values of the nullable fields leave them nil, the double pointer fields are set to a nil pointer.
+		if validations.Nullable && s.document.kind(keyNode+1) == kindNull {
+			if validations.nullField {
+				field := formField(form, validations)
+				field.Set(reflect.New(field.Type().Elem()))
+			}
+			continue
+		}
 
-		// 3.3) Parse and validate the field against the defined validations.
-		if validationsErrors := parseField(validations, fieldName, fieldValue, form, parent); validationsErrors != nil {
+		// 3.4) Parse and validate the field (the member value is the node after its key) against the defined validations.
+		if validationsErrors := s.parseField(validations, fieldName, keyNode+1, form, parent); validationsErrors != nil {
 			errors = append(errors, validationsErrors...)
 		}
 	}
 
-	// 4) Check if all the required fields were sent.
+	// 4) Check if all the required fields were sent, including the ones required by the other fields, and the
+	// subdivisions and the list values of the received fields against the other fields. The fields that were not sent
+	// get their default value, which fulfills their requirement unless it fails the rules of the field, and the optional
+	// ones the zero value when asked. The partial validations have no required fields nor defaults.
 	for fieldName, validations := range validationsMap {
-		if validations.Required {
+		if received[fieldName] {
+			errors = append(errors, s.validateSubdivision(objectNode, validations, fieldName, parent)...)
+			errors = append(errors, s.validateInField(objectNode, validations, fieldName, parent)...)
+			continue
+		}
+		if s.options.partial {
+			continue
+		}
+		if bound, defaultErrors := s.applyDefault(objectNode, form, validations, getFieldName(parent, fieldName)); bound || defaultErrors != nil {
+			errors = append(errors, defaultErrors...)
+			continue
+		}
+		if rule := s.requiredRule(objectNode, validations); rule != "" {
+			s.trigger(getFieldName(parent, fieldName), rule)
 			errors = append(errors, ValidationError{
 				Field:   getFieldName(parent, fieldName),
-				Message: DefaultMessages["RequiredField"],
+				Message: s.options.format("RequiredField", getFieldName(parent, fieldName)),
+				Code:    "required",
 			})
+		} else if validations.ZeroIfAbsent || s.options.zeroIfAbsent {
+			applyZero(form, validations)
 		}
 	}
 
@@ -1,11 +1,16 @@
 package jsonValidator
 
 import (
+	"bytes"
 	"encoding/json"
-	"fmt"
+	"errors"
+	"math"
 	"reflect"
 	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 type Errors []error
@@ -26,6 +31,14 @@ func toFloatPointer(f float64) *float64 {
 func toBoolPointer(b bool) *bool {
 	return &b
 }
+func toTimePointer(t time.Time) *time.Time {
+	return &t
+}
+
+// defaultMessage returns the default message of the key with its placeholders replaced by the values.
+func defaultMessage(key string, values ...any) string {
+	return formatMessage(DefaultMessages[key], placeholderNames(key), "", values...)
+}
 
 func TestTitleCase(t *testing.T) {
 	tests := []struct {
@@ -176,7 +189,7 @@ func TestValidate_BasicTypes(t *testing.T) {
 				form:     new(createObject),
 			},
 			want: want{
-				errors: []error{ValidationError{Field: "json", Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], "{\"name\": \"Daniel\",}")}},
+				errors: []error{ValidationError{Field: "json", Message: defaultMessage("InvalidFormat", "{\"name\": \"Daniel\",}"), Code: "invalid_json", Line: 1, Column: 19}},
 				form:   createObject{},
 			},
 		},
@@ -190,6 +203,7 @@ func TestValidate_BasicTypes(t *testing.T) {
 				errors: []error{ValidationError{
 					Field:   "surname",
 					Message: DefaultMessages["InvalidField"],
+					Code:    "unknown_field",
 				}},
 				form: createObject{Name: toStringPointer("Daniel")},
 			},
@@ -202,14 +216,14 @@ func TestValidate_BasicTypes(t *testing.T) {
 			},
 			want: want{
 				errors: []error{
-					ValidationError{Field: "name", Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], []any{})},
-					ValidationError{Field: "code", Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], "Daniel")},
-					ValidationError{Field: "price", Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], "Daniel")},
-					ValidationError{Field: "successful", Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], 123)},
-					ValidationError{Field: "owners[0]", Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], []any{})},
-					ValidationError{Field: "previousCodes[0]", Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], "Daniel")},
-					ValidationError{Field: "previousPrices[0]", Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], "Daniel")},
-					ValidationError{Field: "previousPrices2", Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], map[string]string{})},
+					ValidationError{Field: "name", Message: defaultMessage("InvalidFormat", []any{}), Code: "invalid_type", Line: 1, Column: 10},
+					ValidationError{Field: "code", Message: defaultMessage("InvalidFormat", "Daniel"), Code: "invalid_type", Line: 1, Column: 22},
+					ValidationError{Field: "price", Message: defaultMessage("InvalidFormat", "Daniel"), Code: "invalid_type", Line: 1, Column: 41},
+					ValidationError{Field: "successful", Message: defaultMessage("InvalidFormat", 123), Code: "invalid_type", Line: 1, Column: 65},
+					ValidationError{Field: "owners[0]", Message: defaultMessage("InvalidFormat", []any{}), Code: "invalid_type", Line: 1, Column: 81},
+					ValidationError{Field: "previousCodes[0]", Message: defaultMessage("InvalidFormat", "Daniel"), Code: "invalid_type", Line: 1, Column: 104},
+					ValidationError{Field: "previousPrices[0]", Message: defaultMessage("InvalidFormat", "Daniel"), Code: "invalid_type", Line: 1, Column: 134},
+					ValidationError{Field: "previousPrices2", Message: defaultMessage("InvalidFormat", map[string]string{}), Code: "invalid_type", Line: 1, Column: 164},
 				},
 				form: createObject{},
 			},
@@ -294,15 +308,15 @@ func TestValidate_Required(t *testing.T) {
 			},
 			want: want{
 				errors: []error{
-					ValidationError{Field: "name", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "code", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "price", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "successful", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "person", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "owners", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "previousCodes", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "previousPrices", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "personList", Message: DefaultMessages["RequiredField"]},
+					ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "code", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "price", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "successful", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "person", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "owners", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "previousCodes", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "previousPrices", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "personList", Message: DefaultMessages["RequiredField"], Code: "required"},
 				},
 				form: createObject{},
 			},
@@ -315,15 +329,15 @@ func TestValidate_Required(t *testing.T) {
 			},
 			want: want{
 				errors: []error{
-					ValidationError{Field: "name", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "code", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "price", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "successful", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "person.name", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "owners", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "previousCodes", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "previousPrices", Message: DefaultMessages["RequiredField"]},
-					ValidationError{Field: "personList[0].name", Message: DefaultMessages["RequiredField"]},
+					ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "code", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "price", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "successful", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "person.name", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "owners", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "previousCodes", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "previousPrices", Message: DefaultMessages["RequiredField"], Code: "required"},
+					ValidationError{Field: "personList[0].name", Message: DefaultMessages["RequiredField"], Code: "required"},
 				},
 				form: createObject{Person: &Person{Age: toIntPointer(26)}, PersonList: []Person{}},
 			},
@@ -423,13 +437,13 @@ func TestValidate_MinMax(t *testing.T) {
 			},
 			want: want{
 				errors: []error{
-					ValidationError{Field: "name", Message: fmt.Sprintf(DefaultMessages["InvalidMinString"], 1)},
-					ValidationError{Field: "code", Message: fmt.Sprintf(DefaultMessages["InvalidMinNumber"], 1)},
-					ValidationError{Field: "price", Message: fmt.Sprintf(DefaultMessages["InvalidMinNumber"], 1)},
-					ValidationError{Field: "owners", Message: fmt.Sprintf(DefaultMessages["InvalidMinList"], 1)},
-					ValidationError{Field: "previousCodes", Message: fmt.Sprintf(DefaultMessages["InvalidMinList"], 1)},
-					ValidationError{Field: "previousPrices", Message: fmt.Sprintf(DefaultMessages["InvalidMinList"], 1)},
-					ValidationError{Field: "personList", Message: fmt.Sprintf(DefaultMessages["InvalidMinList"], 1)},
+					ValidationError{Field: "name", Message: defaultMessage("InvalidMinString", 1), Code: "min"},
+					ValidationError{Field: "code", Message: defaultMessage("InvalidMinNumber", 1), Code: "min"},
+					ValidationError{Field: "price", Message: defaultMessage("InvalidMinNumber", 1), Code: "min"},
+					ValidationError{Field: "owners", Message: defaultMessage("InvalidMinList", 1), Code: "min"},
+					ValidationError{Field: "previousCodes", Message: defaultMessage("InvalidMinList", 1), Code: "min"},
+					ValidationError{Field: "previousPrices", Message: defaultMessage("InvalidMinList", 1), Code: "min"},
+					ValidationError{Field: "personList", Message: defaultMessage("InvalidMinList", 1), Code: "min"},
 				},
 				form: createObject{},
 			},
@@ -442,13 +456,13 @@ func TestValidate_MinMax(t *testing.T) {
 			},
 			want: want{
 				errors: []error{
-					ValidationError{Field: "name", Message: fmt.Sprintf(DefaultMessages["InvalidMaxString"], 10)},
-					ValidationError{Field: "code", Message: fmt.Sprintf(DefaultMessages["InvalidMaxNumber"], 10)},
-					ValidationError{Field: "price", Message: fmt.Sprintf(DefaultMessages["InvalidMaxNumber"], 10)},
-					ValidationError{Field: "owners", Message: fmt.Sprintf(DefaultMessages["InvalidMaxList"], 2)},
-					ValidationError{Field: "previousCodes", Message: fmt.Sprintf(DefaultMessages["InvalidMaxList"], 2)},
-					ValidationError{Field: "previousPrices", Message: fmt.Sprintf(DefaultMessages["InvalidMaxList"], 2)},
-					ValidationError{Field: "personList", Message: fmt.Sprintf(DefaultMessages["InvalidMaxList"], 2)},
+					ValidationError{Field: "name", Message: defaultMessage("InvalidMaxString", 10), Code: "max"},
+					ValidationError{Field: "code", Message: defaultMessage("InvalidMaxNumber", 10), Code: "max"},
+					ValidationError{Field: "price", Message: defaultMessage("InvalidMaxNumber", 10), Code: "max"},
+					ValidationError{Field: "owners", Message: defaultMessage("InvalidMaxList", 2), Code: "max"},
+					ValidationError{Field: "previousCodes", Message: defaultMessage("InvalidMaxList", 2), Code: "max"},
+					ValidationError{Field: "previousPrices", Message: defaultMessage("InvalidMaxList", 2), Code: "max"},
+					ValidationError{Field: "personList", Message: defaultMessage("InvalidMaxList", 2), Code: "max"},
 				},
 				form: createObject{},
 			},
@@ -541,15 +555,15 @@ func TestValidate_Choices(t *testing.T) {
 			},
 			want: want{
 				errors: []error{
-					ValidationError{Field: "name", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], "Daniele", []string{"Daniel"})},
-					ValidationError{Field: "code", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], 101, []string{"1", "2"})},
-					ValidationError{Field: "price", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], 101.0, []string{"1", "2"})},
-					ValidationError{Field: "owners[0]", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], "Jose", []string{"Daniel"})},
-					ValidationError{Field: "owners[1]", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], "Magalhaes", []string{"Daniel"})},
-					ValidationError{Field: "previousCodes[0]", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], 3, []string{"1", "2"})},
-					ValidationError{Field: "previousCodes[1]", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], 4, []string{"1", "2"})},
-					ValidationError{Field: "previousPrices[0]", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], 3.0, []string{"1", "2"})},
-					ValidationError{Field: "previousPrices[1]", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], 4.0, []string{"1", "2"})},
+					ValidationError{Field: "name", Message: defaultMessage("InvalidChoice", "Daniele", []string{"Daniel"}), Code: "choice"},
+					ValidationError{Field: "code", Message: defaultMessage("InvalidChoice", 101, []string{"1", "2"}), Code: "choice"},
+					ValidationError{Field: "price", Message: defaultMessage("InvalidChoice", 101.0, []string{"1", "2"}), Code: "choice"},
+					ValidationError{Field: "owners[0]", Message: defaultMessage("InvalidChoice", "Jose", []string{"Daniel"}), Code: "choice"},
+					ValidationError{Field: "owners[1]", Message: defaultMessage("InvalidChoice", "Magalhaes", []string{"Daniel"}), Code: "choice"},
+					ValidationError{Field: "previousCodes[0]", Message: defaultMessage("InvalidChoice", 3, []string{"1", "2"}), Code: "choice"},
+					ValidationError{Field: "previousCodes[1]", Message: defaultMessage("InvalidChoice", 4, []string{"1", "2"}), Code: "choice"},
+					ValidationError{Field: "previousPrices[0]", Message: defaultMessage("InvalidChoice", 3.0, []string{"1", "2"}), Code: "choice"},
+					ValidationError{Field: "previousPrices[1]", Message: defaultMessage("InvalidChoice", 4.0, []string{"1", "2"}), Code: "choice"},
 				},
 				form: createObject{},
 			},
@@ -562,13 +576,13 @@ func TestValidate_Choices(t *testing.T) {
 			},
 			want: want{
 				errors: []error{
-					ValidationError{Field: "name", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], "Jose", []string{"Daniel"})},
-					ValidationError{Field: "code", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], 10, []string{"1", "2"})},
-					ValidationError{Field: "price", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], 10.0, []string{"1", "2"})},
-					ValidationError{Field: "owners[0]", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], "Jose", []string{"Daniel"})},
-					ValidationError{Field: "owners[1]", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], "Silva", []string{"Daniel"})},
-					ValidationError{Field: "previousCodes[1]", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], 3, []string{"1", "2"})},
-					ValidationError{Field: "previousPrices[1]", Message: fmt.Sprintf(DefaultMessages["InvalidChoice"], 3.0, []string{"1", "2"})},
+					ValidationError{Field: "name", Message: defaultMessage("InvalidChoice", "Jose", []string{"Daniel"}), Code: "choice"},
+					ValidationError{Field: "code", Message: defaultMessage("InvalidChoice", 10, []string{"1", "2"}), Code: "choice"},
+					ValidationError{Field: "price", Message: defaultMessage("InvalidChoice", 10.0, []string{"1", "2"}), Code: "choice"},
+					ValidationError{Field: "owners[0]", Message: defaultMessage("InvalidChoice", "Jose", []string{"Daniel"}), Code: "choice"},
+					ValidationError{Field: "owners[1]", Message: defaultMessage("InvalidChoice", "Silva", []string{"Daniel"}), Code: "choice"},
+					ValidationError{Field: "previousCodes[1]", Message: defaultMessage("InvalidChoice", 3, []string{"1", "2"}), Code: "choice"},
+					ValidationError{Field: "previousPrices[1]", Message: defaultMessage("InvalidChoice", 3.0, []string{"1", "2"}), Code: "choice"},
 				},
 				form: createObject{},
 			},
@@ -643,9 +657,9 @@ func TestValidate_Struct(t *testing.T) {
 			},
 			want: want{
 				errors: []error{
-					ValidationError{Field: "person.firstName", Message: DefaultMessages["InvalidField"]},
-					ValidationError{Field: "personList[0].firstName", Message: DefaultMessages["InvalidField"]},
-					ValidationError{Field: "personList2", Message: fmt.Sprintf(DefaultMessages["InvalidFormat"], map[string]string{})},
+					ValidationError{Field: "person.firstName", Message: DefaultMessages["InvalidField"], Code: "unknown_field"},
+					ValidationError{Field: "personList[0].firstName", Message: DefaultMessages["InvalidField"], Code: "unknown_field"},
+					ValidationError{Field: "personList2", Message: defaultMessage("InvalidFormat", map[string]string{}), Code: "invalid_type", Line: 1, Column: 113},
 				},
 				form: createObject{
 					Person:     &Person{Age: toIntPointer(26)},
@@ -653,6 +667,22 @@ func TestValidate_Struct(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test_structs_type_errors",
+			input: input{
+				jsonData: []byte("{\"person\": \"Daniel\", \"personList\": [{\"name\": \"Jose\"}, 123]}"),
+				form:     new(createObject),
+			},
+			want: want{
+				errors: []error{
+					ValidationError{Field: "person", Message: defaultMessage("InvalidFormat", "Daniel"), Code: "invalid_type", Line: 1, Column: 12},
+					ValidationError{Field: "personList[1]", Message: defaultMessage("InvalidFormat", 123), Code: "invalid_type", Line: 1, Column: 55},
+				},
+				form: createObject{
+					PersonList: []Person{},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -696,3 +726,1536 @@ func TestValidationError_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestValidationErrors_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		errs ValidationErrors
+		want string
+	}{
+		{
+			name: "test_marshal_errors",
+			errs: ValidationErrors{
+				ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "json", Message: "This field has an invalid format (x).", Code: "invalid_json", Line: 1, Column: 2},
+				PayloadTooLargeError{Limit: 10},
+			},
+			want: `{"errors":[{"field":"name","code":"required","message":"This field is required."},{"field":"json","code":"invalid_json","message":"This field has an invalid format (x).","line":1,"column":2},{"message":"Payload must not have more than 10 bytes"}]}`,
+		},
+		{
+			name: "test_marshal_no_errors",
+			errs: ValidationErrors{},
+			want: `{"errors":[]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.errs)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_ListRule(t *testing.T) {
+	type Item struct {
+		Name       *string `validations:"type=string;required=true"`
+		Percentage *int    `validations:"type=int;required=true"`
+	}
+	type createObject struct {
+		Items []Item `validations:"type=[]struct;listRule=percentagesSum"`
+	}
+	RegisterListRule("percentagesSum", func(list any) map[int]string {
+		messages := make(map[int]string)
+		total := 0
+		for i, item := range list.([]Item) {
+			if *item.Percentage == 0 {
+				messages[i] = "The percentage must not be zero."
+			}
+			total += *item.Percentage
+		}
+		if total != 100 {
+			messages[-1] = "The percentages must sum to 100."
+		}
+		return messages
+	})
+	defer delete(ListRules, "percentagesSum")
+
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_list_rule",
+			jsonData: []byte("{\"items\": [{\"name\": \"a\", \"percentage\": 60}, {\"name\": \"b\", \"percentage\": 40}]}"),
+			want:     nil,
+		},
+		{
+			name:     "test_list_rule_errors",
+			jsonData: []byte("{\"items\": [{\"name\": \"a\", \"percentage\": 60}, {\"name\": \"b\", \"percentage\": 0}]}"),
+			want: []error{
+				ValidationError{Field: "items", Message: "The percentages must sum to 100.", Code: "percentagesSum"},
+				ValidationError{Field: "items[1]", Message: "The percentage must not be zero.", Code: "percentagesSum"},
+			},
+		},
+		{
+			name:     "test_list_rule_element_errors",
+			jsonData: []byte("{\"items\": [{\"name\": \"a\"}]}"),
+			want: []error{
+				ValidationError{Field: "items[0].percentage", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_IntegrityCheck(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string"`
+	}
+	errSignature := errors.New("invalid signature")
+	check := func(raw []byte) error {
+		if !bytes.Contains(raw, []byte("Daniel")) {
+			return errSignature
+		}
+		return nil
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+		wantForm createObject
+	}{
+		{
+			name:     "test_integrity_check",
+			jsonData: []byte("{\"name\": \"Daniel\"}"),
+			want:     nil,
+			wantForm: createObject{Name: toStringPointer("Daniel")},
+		},
+		{
+			name:     "test_integrity_check_error",
+			jsonData: []byte("{\"name\": \"Silva\"}"),
+			want:     []error{IntegrityError{Err: errSignature}},
+			wantForm: createObject{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := Validate(tt.jsonData, form, WithIntegrityCheck(check))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			if got != nil && !errors.Is(got[0], errSignature) {
+				t.Errorf("Validate() = %v, want an error wrapping %v", got[0], errSignature)
+			}
+			if !reflect.DeepEqual(*form, tt.wantForm) {
+				t.Errorf("Validate() = %v, want %v", *form, tt.wantForm)
+			}
+		})
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	type createObject struct {
+		Name          *string  `validations:"type=string"`
+		Code          *int     `validations:"type=int"`
+		Price         *float64 `validations:"type=float"`
+		Successful    *bool    `validations:"type=bool"`
+		PreviousCodes []int    `validations:"type=[]int"`
+	}
+	tests := []struct {
+		name          string
+		jsonData      []byte
+		wantErrors    []error
+		wantCoercions []string
+	}{
+		{
+			name:          "test_no_coercions",
+			jsonData:      []byte("{\"name\": \"Daniel\", \"code\": 123, \"price\": 12, \"successful\": true, \"previousCodes\": [1, 2]}"),
+			wantErrors:    nil,
+			wantCoercions: nil,
+		},
+		{
+			name:       "test_coercions",
+			jsonData:   []byte("{\"name\": 123, \"code\": \"123\", \"price\": \"12.3\", \"successful\": \"true\", \"previousCodes\": [1, \"2\"]}"),
+			wantErrors: nil,
+			wantCoercions: []string{
+				"code: string \"123\" coerced to int",
+				"name: number 123 coerced to string",
+				"previousCodes[1]: string \"2\" coerced to int",
+				"price: string \"12.3\" coerced to float",
+				"successful: string \"true\" coerced to bool",
+			},
+		},
+		{
+			name:          "test_coercions_errors",
+			jsonData:      []byte("{\"code\": \"Daniel\", \"successful\": 1}"),
+			wantErrors:    []error{ValidationError{Field: "code", Message: defaultMessage("InvalidFormat", "Daniel"), Code: "invalid_type", Line: 1, Column: 10}},
+			wantCoercions: []string{"successful: number 1 coerced to bool"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			gotErrors, gotCoercions := DryRun(tt.jsonData, form)
+
+			var got []string
+			for _, coercion := range gotCoercions {
+				got = append(got, coercion.String())
+			}
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(gotErrors, tt.wantErrors) {
+				t.Errorf("DryRun() = %v, want %v", gotErrors, tt.wantErrors)
+			}
+			if !reflect.DeepEqual(got, tt.wantCoercions) {
+				t.Errorf("DryRun() = %v, want %v", got, tt.wantCoercions)
+			}
+			if !reflect.DeepEqual(*form, createObject{}) {
+				t.Errorf("DryRun() updated the form %v", *form)
+			}
+		})
+	}
+
+	// The forms that are not a pointer to a struct are reported like Validate, without panicking.
+	for _, form := range []any{nil, createObject{}, (*createObject)(nil)} {
+		gotErrors, _ := DryRun([]byte("{}"), form)
+		if wantErrors := Validate([]byte("{}"), form); !reflect.DeepEqual(gotErrors, wantErrors) {
+			t.Errorf("DryRun(%T) = %v, want %v", form, gotErrors, wantErrors)
+		}
+	}
+}
+
+func TestValidateInto(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string;required=true"`
+		Code *int    `validations:"type=int"`
+	}
+	name, code := "Daniel", 123
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     *createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_valid",
+			jsonData: []byte("{\"name\": \"Daniel\", \"code\": 123}"),
+			want:     &createObject{Name: &name, Code: &code},
+			wantErr:  nil,
+		},
+		{
+			name:     "test_invalid",
+			jsonData: []byte("{\"code\": 123}"),
+			want:     nil,
+			wantErr:  []error{ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotErr := ValidateInto[createObject](tt.jsonData)
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("ValidateInto() errors = %v, want %v", gotErr, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateInto() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_Positions(t *testing.T) {
+	type createObject struct {
+		Name   *string  `validations:"type=string"`
+		Code   *int     `validations:"type=int"`
+		Owners []string `validations:"type=[]string"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_type_error_positions",
+			jsonData: []byte("{\n  \"name\": \"Daniel\",\n  \"code\": \"abc\",\n  \"owners\": [\n    \"é\", {}\n  ]\n}"),
+			want: []error{
+				ValidationError{Field: "code", Message: defaultMessage("InvalidFormat", "abc"), Code: "invalid_type", Line: 3, Column: 11},
+				ValidationError{Field: "owners[1]", Message: defaultMessage("InvalidFormat", map[string]string{}), Code: "invalid_type", Line: 5, Column: 10},
+			},
+		},
+		{
+			name:     "test_syntax_error_position",
+			jsonData: []byte("{\n  \"name\": \"Daniel\"\n  \"code\": 1\n}"),
+			want: []error{
+				ValidationError{Field: "json", Message: defaultMessage("InvalidFormat", "{\n  \"name\": \"Daniel\"\n  \"code\": 1\n}"), Code: "invalid_json", Line: 3, Column: 3},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_MaxBytes(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_max_bytes",
+			jsonData: []byte("{\"name\": \"Daniel\"}"),
+			want:     nil,
+		},
+		{
+			name:     "test_max_bytes_error",
+			jsonData: []byte("{\"name\": \"Daniel Silva\"}"),
+			want:     []error{PayloadTooLargeError{Limit: 18}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject), WithMaxBytes(18))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_ChoicesMessage(t *testing.T) {
+	type createObject struct {
+		Code          *int  `validations:"type=int;choices=1,2,3,4,5"`
+		PreviousCodes []int `validations:"type=[]int;choices=1,2,3,4,5"`
+	}
+	jsonData := []byte("{\"code\": 6, \"previousCodes\": [1, 7]}")
+	tests := []struct {
+		name string
+		opts []Option
+		want []error
+	}{
+		{
+			name: "test_choices_message",
+			opts: nil,
+			want: []error{
+				ValidationError{Field: "code", Message: defaultMessage("InvalidChoice", 6, "[1 2 3 4 5]"), Code: "choice"},
+				ValidationError{Field: "previousCodes[1]", Message: defaultMessage("InvalidChoice", 7, "[1 2 3 4 5]"), Code: "choice"},
+			},
+		},
+		{
+			name: "test_choices_message_limit",
+			opts: []Option{WithChoicesLimit(2)},
+			want: []error{
+				ValidationError{Field: "code", Message: defaultMessage("InvalidChoice", 6, "[1 2] and 3 more"), Code: "choice"},
+				ValidationError{Field: "previousCodes[1]", Message: defaultMessage("InvalidChoice", 7, "[1 2] and 3 more"), Code: "choice"},
+			},
+		},
+		{
+			name: "test_choices_message_without_list",
+			opts: []Option{WithoutChoicesList()},
+			want: []error{
+				ValidationError{Field: "code", Message: defaultMessage("InvalidChoiceWithoutList", 6), Code: "choice"},
+				ValidationError{Field: "previousCodes[1]", Message: defaultMessage("InvalidChoiceWithoutList", 7), Code: "choice"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(jsonData, new(createObject), tt.opts...)
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_ChoiceLabels(t *testing.T) {
+	type createObject struct {
+		Priority   *int     `validations:"type=int;choices=1:Low,2:Medium,3:High"`
+		Tags       []string `validations:"type=[]string;choices=a:Alpha,b"`
+		Priorities []int    `validations:"type=[]int;choices=1:Low,2:Medium,3:High"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+		wantForm createObject
+	}{
+		{
+			name:     "test_choice_labels",
+			jsonData: []byte("{\"priority\": 2, \"tags\": [\"a\", \"b\"], \"priorities\": [1, 3]}"),
+			want:     nil,
+			wantForm: createObject{Priority: toIntPointer(2), Tags: []string{"a", "b"}, Priorities: []int{1, 3}},
+		},
+		{
+			name:     "test_choice_labels_errors",
+			jsonData: []byte("{\"priority\": 4, \"tags\": [\"c\"], \"priorities\": [1, 5]}"),
+			want: []error{
+				ValidationError{Field: "priority", Message: defaultMessage("InvalidChoice", 4, "[Low Medium High]"), Code: "choice"},
+				ValidationError{Field: "tags[0]", Message: defaultMessage("InvalidChoice", "c", "[Alpha b]"), Code: "choice"},
+				ValidationError{Field: "priorities[1]", Message: defaultMessage("InvalidChoice", 5, "[Low Medium High]"), Code: "choice"},
+			},
+			wantForm: createObject{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := Validate(tt.jsonData, form)
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(*form, tt.wantForm) {
+				t.Errorf("Validate() = %v, want %v", *form, tt.wantForm)
+			}
+		})
+	}
+}
+
+func TestValidate_PathPrefix(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string;required=true"`
+	}
+	type createObject struct {
+		Code   *int    `validations:"type=int"`
+		Person *Person `validations:"type=struct"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_path_prefix",
+			jsonData: []byte("{\"code\": 1, \"surname\": \"Silva\", \"person\": {}}"),
+			want: []error{
+				ValidationError{Field: "body.surname", Message: DefaultMessages["InvalidField"], Code: "unknown_field"},
+				ValidationError{Field: "body.person.name", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+		{
+			name:     "test_path_prefix_invalid_json",
+			jsonData: []byte("[]"),
+			want: []error{
+				ValidationError{Field: "body", Message: defaultMessage("InvalidFormat", "[]"), Code: "invalid_type"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject), WithPathPrefix("body"))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_Overrides(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string"`
+	}
+	type createObject struct {
+		Tags       []string `validations:"type=[]string;max=2"`
+		Plan       *string  `validations:"type=string;choices=free:Free,pro:Pro"`
+		PersonList []Person `validations:"type=[]struct"`
+	}
+	overrides := map[string]RuleOverride{
+		"tags":            {Max: toFloatPointer(3)},
+		"plan":            {Choices: []any{"free", "pro", "enterprise"}},
+		"personList.name": {Required: toBoolPointer(true)},
+	}
+	tests := []struct {
+		name      string
+		jsonData  []byte
+		overrides map[string]RuleOverride
+		want      []error
+	}{
+		{
+			name:      "test_overrides",
+			jsonData:  []byte("{\"tags\": [\"a\", \"b\", \"c\"], \"plan\": \"enterprise\", \"personList\": [{}]}"),
+			overrides: overrides,
+			want: []error{
+				ValidationError{Field: "personList[0].name", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+		{
+			name:     "test_without_overrides",
+			jsonData: []byte("{\"tags\": [\"a\", \"b\", \"c\"], \"plan\": \"enterprise\", \"personList\": [{}]}"),
+			want: []error{
+				ValidationError{Field: "tags", Message: defaultMessage("InvalidMaxList", 2), Code: "max"},
+				ValidationError{Field: "plan", Message: defaultMessage("InvalidChoice", "enterprise", []any{"Free", "Pro"}), Code: "choice"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject), WithOverrides(tt.overrides))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_Flags(t *testing.T) {
+	type Person struct {
+		Email *string `validations:"type=string;max=100;flag=strict-emails;max=10"`
+	}
+	type createObject struct {
+		Name   *string `validations:"type=string;flag=strict-names;required=true;flag=;max=20"`
+		Person *Person `validations:"type=struct"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		flags    []string
+		want     []error
+	}{
+		{
+			name:     "test_flags_disabled",
+			jsonData: []byte("{\"person\": {\"email\": \"john.doe@example.com\"}}"),
+			want:     nil,
+		},
+		{
+			name:     "test_flags_enabled",
+			jsonData: []byte("{\"person\": {\"email\": \"john.doe@example.com\"}}"),
+			flags:    []string{"strict-emails", "strict-names"},
+			want: []error{
+				ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "person.email", Message: defaultMessage("InvalidMaxString", 10), Code: "max"},
+			},
+		},
+		{
+			name:     "test_unguarded_rules",
+			jsonData: []byte("{\"name\": \"John Jacob Jingleheimer Schmidt\"}"),
+			flags:    []string{"strict-emails"},
+			want: []error{
+				ValidationError{Field: "name", Message: defaultMessage("InvalidMaxString", 20), Code: "max"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject), WithFlags(tt.flags...))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_PointerLists(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string"`
+	}
+	type createObject struct {
+		Tags       *[]string `validations:"type=[]string;max=2"`
+		Codes      *[]int    `validations:"type=[]int"`
+		PersonList *[]Person `validations:"type=[]struct"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     *createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_absent_lists",
+			jsonData: []byte("{}"),
+			want:     &createObject{},
+		},
+		{
+			name:     "test_empty_lists",
+			jsonData: []byte("{\"tags\": [], \"codes\": [], \"personList\": []}"),
+			want:     &createObject{Tags: &[]string{}, Codes: &[]int{}, PersonList: &[]Person{}},
+		},
+		{
+			name:     "test_lists",
+			jsonData: []byte("{\"tags\": [\"a\"], \"codes\": [1, 2], \"personList\": [{\"name\": \"John\"}]}"),
+			want: &createObject{
+				Tags:       &[]string{"a"},
+				Codes:      &[]int{1, 2},
+				PersonList: &[]Person{{Name: toStringPointer("John")}},
+			},
+		},
+		{
+			name:     "test_invalid_lists",
+			jsonData: []byte("{\"tags\": [\"a\", \"b\", \"c\"], \"personList\": [{\"name\": []}]}"),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "tags", Message: defaultMessage("InvalidMaxList", 2), Code: "max"},
+				ValidationError{Field: "personList[0].name", Message: defaultMessage("InvalidFormat", []any{}), Code: "invalid_type", Line: 1, Column: 51},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(createObject)
+			gotErr := Validate(tt.jsonData, got)
+
+			// Sort
+			sort.Sort(Errors(gotErr))
+			sort.Sort(Errors(tt.wantErr))
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", gotErr, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() form = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_PointerElements(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string;required=true"`
+	}
+	type createObject struct {
+		PersonList []*Person  `validations:"type=[]struct;max=3"`
+		Owners     *[]*Person `validations:"type=[]struct"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     *createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_pointer_elements",
+			jsonData: []byte("{\"personList\": [{\"name\": \"John\"}, null], \"owners\": [{\"name\": \"Ana\"}]}"),
+			want: &createObject{
+				PersonList: []*Person{{Name: toStringPointer("John")}, nil},
+				Owners:     &[]*Person{{Name: toStringPointer("Ana")}},
+			},
+		},
+		{
+			name:     "test_pointer_elements_empty",
+			jsonData: []byte("{\"personList\": [], \"owners\": []}"),
+			want:     &createObject{Owners: &[]*Person{}},
+		},
+		{
+			name:     "test_invalid_pointer_elements",
+			jsonData: []byte("{\"personList\": [{}, 1, {}, {}], \"owners\": [{\"name\": 1}, {}]}"),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "personList", Message: defaultMessage("InvalidMaxList", 3), Code: "max"},
+				ValidationError{Field: "owners[1].name", Message: defaultMessage("RequiredField"), Code: "required"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(createObject)
+			gotErr := Validate(tt.jsonData, got)
+
+			// Sort
+			sort.Sort(Errors(gotErr))
+			sort.Sort(Errors(tt.wantErr))
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", gotErr, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() form = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_ValueFields(t *testing.T) {
+	type Person struct {
+		Name string `validations:"type=string;required=true"`
+	}
+	type createObject struct {
+		Name       string    `validations:"type=string;min=2"`
+		Code       int       `validations:"type=int;required=true"`
+		Price      float64   `validations:"type=float"`
+		Successful bool      `validations:"type=bool"`
+		Birthday   time.Time `validations:"type=datetime;format=2006-01-02"`
+		PersonList []Person  `validations:"type=[]struct"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     *createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_value_fields",
+			jsonData: []byte("{\"name\": \"Daniel\", \"code\": \"123\", \"price\": 12.5, \"successful\": true, \"birthday\": \"1990-01-02\", \"personList\": [{\"name\": \"John\"}]}"),
+			want: &createObject{
+				Name:       "Daniel",
+				Code:       123,
+				Price:      12.5,
+				Successful: true,
+				Birthday:   time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC),
+				PersonList: []Person{{Name: "John"}},
+			},
+		},
+		{
+			name:     "test_value_fields_required",
+			jsonData: []byte("{\"name\": \"D\", \"personList\": [{}]}"),
+			want:     &createObject{PersonList: []Person{}},
+			wantErr: []error{
+				ValidationError{Field: "code", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "name", Message: defaultMessage("InvalidMinString", 2), Code: "min"},
+				ValidationError{Field: "personList[0].name", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(createObject)
+			gotErr := Validate(tt.jsonData, got)
+
+			// Sort
+			sort.Sort(Errors(gotErr))
+			sort.Sort(Errors(tt.wantErr))
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", gotErr, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() form = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_SizedInts(t *testing.T) {
+	type createObject struct {
+		Id     *int64   `validations:"type=int"`
+		Code   *int32   `validations:"type=int;max=1000"`
+		Count  uint     `validations:"type=int"`
+		Level  *uint8   `validations:"type=int"`
+		Shards []uint16 `validations:"type=[]int"`
+	}
+	id, code, level, precise := int64(30000000000), int32(12), uint8(255), int64(9007199254740993)
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     *createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_sized_ints",
+			jsonData: []byte("{\"id\": 3e10, \"code\": \"12\", \"count\": 3, \"level\": 255, \"shards\": [1, 65535]}"),
+			want:     &createObject{Id: &id, Code: &code, Count: 3, Level: &level, Shards: []uint16{1, 65535}},
+		},
+		{
+			name:     "test_sized_ints_overflow",
+			jsonData: []byte("{\"code\": 3e10, \"count\": -1, \"level\": 256, \"shards\": [65536]}"),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "code", Message: defaultMessage("OutOfRange", math.MinInt32, math.MaxInt32), Code: "out_of_range", Line: 1, Column: 10},
+				ValidationError{Field: "count", Message: defaultMessage("OutOfRange", 0, uint64(math.MaxUint)), Code: "out_of_range", Line: 1, Column: 25},
+				ValidationError{Field: "level", Message: defaultMessage("OutOfRange", 0, math.MaxUint8), Code: "out_of_range", Line: 1, Column: 38},
+				ValidationError{Field: "shards[0]", Message: defaultMessage("OutOfRange", 0, math.MaxUint16), Code: "out_of_range", Line: 1, Column: 54},
+			},
+		},
+		{
+			name:     "test_sized_ints_precision",
+			jsonData: []byte("{\"id\": 9007199254740993, \"count\": 18014398509481985}"),
+			want:     &createObject{Id: &precise, Count: 18014398509481985},
+		},
+		{
+			name:     "test_sized_ints_unsigned_range",
+			jsonData: []byte("{\"count\": \"18446744073709551615\", \"shards\": [6.5535e4]}"),
+			want:     &createObject{Count: math.MaxUint, Shards: []uint16{65535}},
+		},
+		{
+			name:     "test_sized_ints_out_of_range_strings",
+			jsonData: []byte("{\"id\": \"9223372036854775808\", \"code\": 1.5, \"shards\": [\"-1\"]}"),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "id", Message: defaultMessage("OutOfRange", math.MinInt64, math.MaxInt64), Code: "out_of_range", Line: 1, Column: 8},
+				ValidationError{Field: "code", Message: defaultMessage("InvalidFormat", 1.5), Code: "invalid_type", Line: 1, Column: 39},
+				ValidationError{Field: "shards[0]", Message: defaultMessage("OutOfRange", 0, math.MaxUint16), Code: "out_of_range", Line: 1, Column: 55},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(createObject)
+			gotErr := Validate(tt.jsonData, got)
+
+			// Sort
+			sort.Sort(Errors(gotErr))
+			sort.Sort(Errors(tt.wantErr))
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", gotErr, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() form = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_Nullable(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string"`
+	}
+	type createObject struct {
+		Nickname **string `validations:"type=string;required=true;nullable=true"`
+		Code     *int     `validations:"type=int;nullable=true"`
+		Person   *Person  `validations:"type=struct;nullable=true"`
+		Name     *string  `validations:"type=string"`
+	}
+	nickname, code := toStringPointer("Dani"), 12
+	var null *string
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     *createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_values",
+			jsonData: []byte("{\"nickname\": \"Dani\", \"code\": 12}"),
+			want:     &createObject{Nickname: &nickname, Code: &code},
+		},
+		{
+			name:     "test_nulls",
+			jsonData: []byte("{\"nickname\": null, \"code\": null, \"person\": null}"),
+			want:     &createObject{Nickname: &null},
+		},
+		{
+			name:     "test_missing",
+			jsonData: []byte("{\"name\": null}"),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "name", Message: defaultMessage("InvalidFormat", nil), Code: "invalid_type", Line: 1, Column: 10},
+				ValidationError{Field: "nickname", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(createObject)
+			gotErr := Validate(tt.jsonData, got)
+
+			// Sort
+			sort.Sort(Errors(gotErr))
+			sort.Sort(Errors(tt.wantErr))
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", gotErr, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() form = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_NullableSizedInts(t *testing.T) {
+	type createObject struct {
+		Code  **int32 `validations:"type=int;nullable=true"`
+		Level **uint8 `validations:"type=int;nullable=true"`
+		Id    **int64 `validations:"type=int;nullable=true"`
+	}
+	code, level, id := int32(4), uint8(255), int64(30000000000)
+	codePointer, levelPointer, idPointer := &code, &level, &id
+	var nullCode *int32
+	var nullLevel *uint8
+	var nullId *int64
+	tests := []struct {
+		name     string
+		jsonData []byte
+		opts     []Option
+		want     *createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_values",
+			jsonData: []byte("{\"code\": 4, \"level\": 255, \"id\": 3e10}"),
+			want:     &createObject{Code: &codePointer, Level: &levelPointer, Id: &idPointer},
+		},
+		{
+			name:     "test_nulls",
+			jsonData: []byte("{\"code\": null, \"level\": null, \"id\": null}"),
+			want:     &createObject{Code: &nullCode, Level: &nullLevel, Id: &nullId},
+		},
+		{
+			name:     "test_missing",
+			jsonData: []byte("{}"),
+			want:     &createObject{},
+		},
+		{
+			name:     "test_missing_zero_if_absent",
+			jsonData: []byte("{}"),
+			opts:     []Option{WithZeroIfAbsent()},
+			want:     &createObject{},
+		},
+		{
+			name:     "test_out_of_range",
+			jsonData: []byte("{\"level\": 256}"),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "level", Message: defaultMessage("OutOfRange", 0, math.MaxUint8), Code: "out_of_range", Line: 1, Column: 11},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(createObject)
+			gotErr := Validate(tt.jsonData, got, tt.opts...)
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", gotErr, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() form = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	// The generated conformance cases of the form match what Validate reports.
+	for _, conformanceCase := range GenerateConformanceCases(createObject{}) {
+		if gotErr := Validate(conformanceCase.Payload, new(createObject)); len(gotErr) != len(conformanceCase.Errors) {
+			t.Errorf("GenerateConformanceCases() %s = %v, Validate() = %v", conformanceCase.Name, conformanceCase.Errors, gotErr)
+		}
+	}
+}
+
+func TestValidate_AllowEmptyList(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string"`
+	}
+	type createObject struct {
+		Owners     []string `validations:"type=[]string;required=true"`
+		Tags       []string `validations:"type=[]string;required=true;allowEmptyList=false"`
+		PersonList []Person `validations:"type=[]struct;allowEmptyList=false"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_empty_lists",
+			jsonData: []byte("{\"owners\": [], \"tags\": [], \"personList\": []}"),
+			want: []error{
+				ValidationError{Field: "tags", Message: DefaultMessages["EmptyList"], Code: "empty_list"},
+				ValidationError{Field: "personList", Message: DefaultMessages["EmptyList"], Code: "empty_list"},
+			},
+		},
+		{
+			name:     "test_missing_lists",
+			jsonData: []byte("{}"),
+			want: []error{
+				ValidationError{Field: "owners", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "tags", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+		{
+			name:     "test_lists_with_content",
+			jsonData: []byte("{\"owners\": [\"a\"], \"tags\": [\"b\"], \"personList\": [{}]}"),
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_Pattern(t *testing.T) {
+	type createObject struct {
+		Slug  *string  `validations:"type=string;pattern=^[a-z0-9-]+$"`
+		Tags  []string `validations:"type=[]string;pattern=^#[a-z]+$"`
+		Code  *string  `validations:"type=string;pattern=[invalid"`
+		Codes []string `validations:"type=[]string;pattern=[invalid"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_pattern_match",
+			jsonData: []byte("{\"slug\": \"my-object-1\", \"tags\": [\"#go\", \"#json\"]}"),
+			want:     nil,
+		},
+		{
+			name:     "test_pattern_invalid",
+			jsonData: []byte("{\"code\": \"abc\"}"),
+			want:     []error{ConfigError{Message: "the pattern [invalid is not a valid regular expression"}},
+		},
+		{
+			name:     "test_pattern_invalid_list",
+			jsonData: []byte("{\"codes\": [\"abc\"]}"),
+			want:     []error{ConfigError{Message: "the pattern [invalid is not a valid regular expression"}},
+		},
+		{
+			name:     "test_pattern_mismatch",
+			jsonData: []byte("{\"slug\": \"My Object\", \"tags\": [\"#go\", \"json\"]}"),
+			want: []error{
+				ValidationError{Field: "slug", Message: defaultMessage("InvalidPattern", "^[a-z0-9-]+$"), Code: "pattern"},
+				ValidationError{Field: "tags[1]", Message: defaultMessage("InvalidPattern", "^#[a-z]+$"), Code: "pattern"},
+			},
+		},
+		{
+			name:     "test_pattern_mismatch_after_duplicates",
+			jsonData: []byte("{\"tags\": [\"#go\", \"#go\", \"go\"]}"),
+			want: []error{
+				ValidationError{Field: "tags[2]", Message: defaultMessage("InvalidPattern", "^#[a-z]+$"), Code: "pattern"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_StringFormats(t *testing.T) {
+	type createObject struct {
+		Email    *string  `validations:"type=string;format=email;normalize=email"`
+		Website  *string  `validations:"type=string;format=url"`
+		Id       *string  `validations:"type=string;format=uuid"`
+		OrderId  *string  `validations:"type=string;format=ulid"`
+		EventId  *string  `validations:"type=string;format=ksuid"`
+		Contacts []string `validations:"type=[]string;format=email"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_formats",
+			jsonData: []byte(`{"email": "john@Example.com", "website": "https://example.com/about", "id": "123e4567-e89b-12d3-a456-426614174000", "orderId": "01ARZ3NDEKTSV4RRFFQ69G5FAV", "eventId": "0ujtsYcgvSTl8PAuAdqWYSMnLOv", "contacts": ["jane@example.com"]}`),
+			want:     nil,
+		},
+		{
+			name:     "test_formats_invalid",
+			jsonData: []byte(`{"email": "John <john@example.com>", "website": "example.com", "id": "123e4567", "orderId": "81ARZ3NDEKTSV4RRFFQ69G5FAV", "eventId": "zzzzzzzzzzzzzzzzzzzzzzzzzzz", "contacts": ["jane@example.com", "jack"]}`),
+			want: []error{
+				ValidationError{Field: "contacts[1]", Message: DefaultMessages["InvalidEmail"], Code: "format"},
+				ValidationError{Field: "email", Message: DefaultMessages["InvalidEmail"], Code: "format"},
+				ValidationError{Field: "eventId", Message: DefaultMessages["InvalidKsuid"], Code: "format"},
+				ValidationError{Field: "id", Message: DefaultMessages["InvalidUuid"], Code: "format"},
+				ValidationError{Field: "orderId", Message: DefaultMessages["InvalidUlid"], Code: "format"},
+				ValidationError{Field: "website", Message: DefaultMessages["InvalidUrl"], Code: "format"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_Bidi(t *testing.T) {
+	type createObject struct {
+		Filename *string  `validations:"type=string;allowBidi=false"`
+		Urls     []string `validations:"type=[]string;allowBidi=false"`
+		Comment  *string  `validations:"type=string"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_bidi_absent",
+			jsonData: []byte("{\"filename\": \"invoice.pdf\", \"urls\": [\"https://example.com\"], \"comment\": \"\u202eok\"}"),
+			want:     nil,
+		},
+		{
+			name:     "test_bidi_rejected",
+			jsonData: []byte("{\"filename\": \"invoice\u202egpj.exe\", \"urls\": [\"https://example.com\", \"https://\u2067example.com\"]}"),
+			want: []error{
+				ValidationError{Field: "filename", Message: DefaultMessages["InvalidBidi"], Code: "bidi"},
+				ValidationError{Field: "urls[1]", Message: DefaultMessages["InvalidBidi"], Code: "bidi"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_Emoji(t *testing.T) {
+	type createObject struct {
+		LegalName  *string  `validations:"type=string;noEmoji=true"`
+		References []string `validations:"type=[]string;noEmoji=true"`
+		Comment    *string  `validations:"type=string"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_emoji_absent",
+			jsonData: []byte("{\"legalName\": \"Jos\u00e9 P\u00e9rez & Co.\", \"references\": [\"INV-2024/001 \u20ac100+\"], \"comment\": \"Thanks \U0001F600\"}"),
+			want:     nil,
+		},
+		{
+			name:     "test_emoji_rejected",
+			jsonData: []byte("{\"legalName\": \"Acme \u2764\ufe0f\", \"references\": [\"INV-001\", \"INV-\U0001F44D\U0001F3FD\"]}"),
+			want: []error{
+				ValidationError{Field: "legalName", Message: DefaultMessages["InvalidEmoji"], Code: "emoji"},
+				ValidationError{Field: "references[1]", Message: DefaultMessages["InvalidEmoji"], Code: "emoji"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type paymentMethod interface {
+	isPaymentMethod()
+}
+
+type cardPayment struct {
+	Type   *string `validations:"type=string"`
+	Number *string `validations:"type=string;required=true;min=12"`
+}
+
+func (*cardPayment) isPaymentMethod() {}
+
+type bankPayment struct {
+	Type *string `validations:"type=string"`
+	Iban *string `validations:"type=string;required=true"`
+}
+
+func (bankPayment) isPaymentMethod() {}
+
+func TestValidate_Implementations(t *testing.T) {
+	RegisterImplementation("card", cardPayment{})
+	RegisterImplementation("bank", new(bankPayment))
+	type createObject struct {
+		Payment paymentMethod `validations:"type=struct;impl=card|bank"`
+		Refund  paymentMethod `validations:"type=struct;impl=card;discriminator=method"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     *createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_pointer_implementation",
+			jsonData: []byte("{\"payment\": {\"type\": \"card\", \"number\": \"4111111111111111\"}}"),
+			want:     &createObject{Payment: &cardPayment{Type: toStringPointer("card"), Number: toStringPointer("4111111111111111")}},
+		},
+		{
+			name:     "test_value_implementation",
+			jsonData: []byte("{\"payment\": {\"type\": \"bank\", \"iban\": \"PT50000201231234567890154\"}}"),
+			want:     &createObject{Payment: bankPayment{Type: toStringPointer("bank"), Iban: toStringPointer("PT50000201231234567890154")}},
+		},
+		{
+			name:     "test_invalid_implementation",
+			jsonData: []byte("{\"payment\": {\"type\": \"card\", \"number\": \"4111\"}, \"refund\": {\"method\": \"bank\"}}"),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "payment.number", Message: defaultMessage("InvalidMinString", 12), Code: "min"},
+				ValidationError{Field: "refund.method", Message: defaultMessage("InvalidChoice", "bank", []any{"card"}), Code: "choice"},
+			},
+		},
+		{
+			name:     "test_missing_discriminator",
+			jsonData: []byte("{\"payment\": {\"number\": \"4111111111111111\"}}"),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "payment.type", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(createObject)
+			gotErr := Validate(tt.jsonData, got)
+
+			// Sort
+			sort.Sort(Errors(gotErr))
+			sort.Sort(Errors(tt.wantErr))
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", gotErr, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() form = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_Strict(t *testing.T) {
+	type createObject struct {
+		Name  *string  `validations:"type=string;strict=true"`
+		Code  *int     `validations:"type=int"`
+		Codes []int    `validations:"type=[]int;strict=true"`
+		Price *float64 `validations:"type=float"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		opts     []Option
+		want     []error
+	}{
+		{
+			name:     "test_strict_field",
+			jsonData: []byte(`{"name": 123, "code": "1", "codes": [1, "2"], "price": 1}`),
+			want: []error{
+				ValidationError{Field: "codes[1]", Message: defaultMessage("InvalidFormat", "2"), Code: "invalid_type", Line: 1, Column: 41},
+				ValidationError{Field: "name", Message: defaultMessage("InvalidFormat", 123), Code: "invalid_type", Line: 1, Column: 10},
+			},
+		},
+		{
+			name:     "test_strict_types",
+			jsonData: []byte(`{"name": "abc", "code": "1", "codes": [1, 2], "price": 1}`),
+			opts:     []Option{WithStrictTypes()},
+			want: []error{
+				ValidationError{Field: "code", Message: defaultMessage("InvalidFormat", "1"), Code: "invalid_type", Line: 1, Column: 25},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject), tt.opts...)
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_AllowUnknownFields(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string"`
+	}
+	type createObject struct {
+		Name     *string   `validations:"type=string;required=true"`
+		Person   *Person   `validations:"type=struct"`
+		Location *GeoPoint `validations:"type=geo"`
+	}
+	jsonData := []byte(`{"name": "Object", "event": "created", "person": {"name": "John", "age": 30}, "location": {"lat": 1, "lng": 2, "alt": 3}}`)
+	tests := []struct {
+		name string
+		opts []Option
+		want []error
+	}{
+		{
+			name: "test_unknown_fields",
+			want: []error{
+				ValidationError{Field: "event", Message: DefaultMessages["InvalidField"], Code: "unknown_field"},
+				ValidationError{Field: "location.alt", Message: DefaultMessages["InvalidField"], Code: "unknown_field"},
+				ValidationError{Field: "person.age", Message: DefaultMessages["InvalidField"], Code: "unknown_field"},
+			},
+		},
+		{
+			name: "test_allow_unknown_fields",
+			opts: []Option{WithAllowUnknownFields()},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(jsonData, new(createObject), tt.opts...)
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_Partial(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string;required=true"`
+		Age  *int    `validations:"type=int;min=18"`
+	}
+	type updateObject struct {
+		Name   *string `validations:"type=string;required=true;max=10"`
+		Code   *int    `validations:"type=int;choices=1,2,3"`
+		Reason *string `validations:"type=string;required_if=code:3"`
+		Person *Person `validations:"type=struct"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		opts     []Option
+		want     []error
+	}{
+		{
+			name:     "test_required",
+			jsonData: []byte(`{"code": 3, "person": {}}`),
+			want: []error{
+				ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "person.name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "reason", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+		{
+			name:     "test_partial",
+			jsonData: []byte(`{"code": 3, "person": {}}`),
+			opts:     []Option{WithPartial()},
+			want:     nil,
+		},
+		{
+			name:     "test_partial_received_fields",
+			jsonData: []byte(`{"code": 4, "person": {"age": 17}}`),
+			opts:     []Option{WithPartial()},
+			want: []error{
+				ValidationError{Field: "code", Message: defaultMessage("InvalidChoice", 4, []any{1, 2, 3}), Code: "choice"},
+				ValidationError{Field: "person.age", Message: defaultMessage("InvalidMinNumber", 18), Code: "min"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(updateObject), tt.opts...)
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestValidate_Concurrent validates the same form type from several goroutines, run it with -race.
+func TestValidate_Concurrent(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string;required=true"`
+	}
+	type createObject struct {
+		Name       *string  `validations:"type=string;required=true"`
+		Code       *int     `validations:"type=int;required=true"`
+		PersonList []Person `validations:"type=[]struct"`
+	}
+	payloads := []struct {
+		jsonData []byte
+		want     []error
+	}{
+		{
+			jsonData: []byte(`{"name": "Object", "code": 1, "personList": [{"name": "Jane"}]}`),
+			want:     nil,
+		},
+		{
+			jsonData: []byte(`{"name": "Object", "personList": [{}]}`),
+			want: []error{
+				ValidationError{Field: "code", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "personList[0].name", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		payload := payloads[i%len(payloads)]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got := Validate(payload.jsonData, new(createObject))
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, payload.want) {
+				t.Errorf("Validate() = %v, want %v", got, payload.want)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkValidate(b *testing.B) {
+	type Person struct {
+		Name *string `validations:"type=string;required=true"`
+		Age  *int    `validations:"type=int;min=0;max=150"`
+	}
+	type createObject struct {
+		Name       *string  `validations:"type=string;min=1;max=50"`
+		Code       *int     `validations:"type=int"`
+		Price      *float64 `validations:"type=float"`
+		Successful *bool    `validations:"type=bool"`
+		Person     *Person  `validations:"type=struct"`
+		PersonList []Person `validations:"type=[]struct"`
+	}
+	jsonData := []byte(`{"name": "Object", "code": 1, "price": 9.99, "successful": true, "person": {"name": "John", "age": 30},
+		"personList": [{"name": "Jane", "age": 28}, {"name": "Jack", "age": 45}]}`)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if errs := Validate(jsonData, new(createObject)); errs != nil {
+			b.Fatal(errs)
+		}
+	}
+}
+
+func BenchmarkValidate_StructList(b *testing.B) {
+	type Person struct {
+		Name *string `validations:"type=string;required=true"`
+		Age  *int    `validations:"type=int;min=0;max=150"`
+	}
+	type createObject struct {
+		PersonList []Person `validations:"type=[]struct"`
+	}
+	elements := make([]string, 1000)
+	for i := range elements {
+		elements[i] = `{"name": "Jane", "age": 28}`
+	}
+	jsonData := []byte(`{"personList": [` + strings.Join(elements, ", ") + `]}`)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if errs := Validate(jsonData, new(createObject)); errs != nil {
+			b.Fatal(errs)
+		}
+	}
+}
+
+func TestValidate_Datetime(t *testing.T) {
+	type createObject struct {
+		CreatedAt *time.Time `validations:"type=datetime"`
+		Birthday  *time.Time `validations:"type=datetime;format=2006-01-02"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     *createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_datetimes",
+			jsonData: []byte("{\"createdAt\": \"2024-03-01T10:30:00Z\", \"birthday\": \"1990-12-31\"}"),
+			want: &createObject{
+				CreatedAt: toTimePointer(time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)),
+				Birthday:  toTimePointer(time.Date(1990, 12, 31, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:     "test_invalid_datetimes",
+			jsonData: []byte("{\"createdAt\": 1709289000, \"birthday\": \"31/12/1990\"}"),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "createdAt", Message: defaultMessage("InvalidFormat", 1709289000.0), Code: "invalid_type", Line: 1, Column: 15},
+				ValidationError{Field: "birthday", Message: defaultMessage("InvalidDatetime", "31/12/1990", "2006-01-02"), Code: "format", Line: 1, Column: 39},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(createObject)
+			gotErr := Validate(tt.jsonData, got)
+
+			// Sort
+			sort.Sort(Errors(gotErr))
+			sort.Sort(Errors(tt.wantErr))
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", gotErr, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() form = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package jsonValidator
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// jsonSchemaTypes maps the validation types to their JSON Schema types and formats.
+var jsonSchemaTypes = map[string][2]string{
+	"string":   {"string", ""},
+	"int":      {"integer", ""},
+	"float":    {"number", ""},
+	"bool":     {"boolean", ""},
+	"datetime": {"string", "date-time"},
+	"geo":      {"object", ""},
+	"money":    {"object", ""},
+	"file":     {"string", "binary"},
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12, also an OpenAPI 3.1 schema object) of the body of a form (a struct
+// or a pointer to a struct): the types, the required fields and the length, range, pattern and choices rules of its
+// fields, with the "desc=" and "example=" values as their "description" and "examples". The examples that are valid
+// json are exported as json, the other ones as strings. The fields of the query, path and header sections and the
+// rules without an equivalent keyword are left out, and the recursive forms are only described once, as objects
+// without properties below.
+func JSONSchema(form any) map[string]any {
+	schema := formSchema(structType(reflect.TypeOf(form)))
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	return schema
+}
+
+func formSchema(formType reflect.Type, ancestors ...reflect.Type) map[string]any {
+
+	// 1) Get the validations of the form, the interface fields have no declared fields.
+	schema := map[string]any{"type": "object"}
+	if formType.Kind() != reflect.Struct || containsType(ancestors, formType) {
+		return schema
+	}
+	ancestors = append(ancestors, formType)
+	syntax := defaultSyntax()
+	validationsMap := getValidations(reflect.New(formType).Elem(), syntax)
+
+	// 2) Add the schema of each field, in the order of the form.
+	properties := make(map[string]any)
+	var required []string
+	for _, field := range formFields(formType, syntax) {
+		validations := validationsMap[LowerCase(field.Name)]
+		if validations == nil || validations.Type == "" || validations.In != "" && validations.In != "body" {
+			continue
+		}
+		properties[LowerCase(field.Name)] = fieldSchema(validations, field.Type, ancestors)
+		if validations.Required {
+			required = append(required, LowerCase(field.Name))
+		}
+	}
+
+	// 3) Return the schema.
+	schema["properties"] = properties
+	if required != nil {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func fieldSchema(validations *Validations, fieldType reflect.Type, ancestors []reflect.Type) map[string]any {
+
+	// 1) Get the schema of the type: the lists and the maps describe their elements, and the structs their form.
+	var schema map[string]any
+	switch {
+	case strings.HasPrefix(validations.Type, "map[string]"):
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		values := *validations.Values
+		values.Type = strings.TrimPrefix(validations.Type, "map[string]")
+		schema = map[string]any{"type": "object", "additionalProperties": fieldSchema(&values, fieldType.Elem(), ancestors)}
+		setSchema(schema, "minProperties", validations.MinKeys)
+		setSchema(schema, "maxProperties", validations.MaxKeys)
+	case strings.HasPrefix(validations.Type, "[]"):
+		element := Validations{Type: strings.TrimPrefix(validations.Type, "[]"), Pattern: validations.Pattern, Choices: validations.Choices}
+		schema = map[string]any{"type": "array", "items": fieldSchema(&element, structType(fieldType), ancestors)}
+		setSchema(schema, "minItems", int(validations.Min))
+		setSchema(schema, "maxItems", int(validations.Max))
+		setSchema(schema, "minItems", validations.Len)
+		setSchema(schema, "maxItems", validations.Len)
+	case validations.Type == "struct":
+		schema = formSchema(structType(fieldType), ancestors...)
+	default:
+		schema = map[string]any{}
+		if jsonType, ok := jsonSchemaTypes[validations.Type]; ok {
+			schema["type"] = jsonType[0]
+			setSchema(schema, "format", jsonType[1])
+		}
+	}
+
+	// 2) Add the range and the length of the scalars.
+	switch validations.Type {
+	case "string":
+		setSchema(schema, "minLength", int(validations.Min))
+		setSchema(schema, "maxLength", int(validations.Max))
+		setSchema(schema, "minLength", validations.Len)
+		setSchema(schema, "maxLength", validations.Len)
+	case "int", "float":
+		setSchema(schema, "minimum", validations.Min)
+		setSchema(schema, "maximum", validations.Max)
+	}
+
+	// 3) Add the pattern and the choices of the scalars.
+	if validations.Pattern != nil && schema["type"] == "string" {
+		schema["pattern"] = validations.Pattern.String()
+	}
+	if validations.Choices != nil && !strings.HasPrefix(validations.Type, "[]") {
+		schema["enum"] = validations.Choices
+	}
+	if validations.Nullable {
+		if jsonType, ok := schema["type"].(string); ok {
+			schema["type"] = []string{jsonType, "null"}
+		}
+	}
+
+	// 4) Add the description and the example.
+	setSchema(schema, "description", validations.Description)
+	if validations.Example != "" {
+		var example any = validations.Example
+		if json.Valid([]byte(validations.Example)) {
+			_ = json.Unmarshal([]byte(validations.Example), &example)
+		}
+		schema["examples"] = []any{example}
+	}
+	return schema
+}
+
+// setSchema sets a keyword of the schema, unless its value is the zero value.
+func setSchema[T comparable](schema map[string]any, keyword string, value T) {
+	var zero T
+	if value != zero {
+		schema[keyword] = value
+	}
+}
@@ -0,0 +1,63 @@
+package jsonValidator
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONSchema(t *testing.T) {
+	type person struct {
+		Name *string `validations:"type=string;required=true;max=50;desc=Full name;example=Daniel Silva"`
+	}
+	type recursive struct {
+		Children []recursive `validations:"type=[]struct"`
+	}
+	type createObject struct {
+		Code     *int              `validations:"type=int;required=true;min=1;max=999;desc=Code of the object;example=123"`
+		Status   *string           `validations:"type=string;choices=active,inactive;nullable=true"`
+		Slug     *string           `validations:"type=string;pattern=^[a-z-]+$"`
+		Tags     []string          `validations:"type=[]string;max=5;choices=a,b"`
+		Labels   map[string]string `validations:"type=map[string]string;maxKeys=3;max=10;example={\"env\":\"production\"}"`
+		Person   *person           `validations:"type=struct;desc=Owner of the object"`
+		Tree     *recursive        `validations:"type=struct"`
+		Page     *int              `validations:"in=query;type=int"`
+		Internal *string
+	}
+	tests := []struct {
+		name string
+		form any
+		want string
+	}{
+		{
+			name: "test_json_schema",
+			form: createObject{},
+			want: `{"$schema":"https://json-schema.org/draft/2020-12/schema","properties":{` +
+				`"code":{"description":"Code of the object","examples":[123],"maximum":999,"minimum":1,"type":"integer"},` +
+				`"labels":{"additionalProperties":{"maxLength":10,"type":"string"},"examples":[{"env":"production"}],"maxProperties":3,"type":"object"},` +
+				`"person":{"description":"Owner of the object","properties":{"name":{"description":"Full name","examples":["Daniel Silva"],"maxLength":50,"type":"string"}},"required":["name"],"type":"object"},` +
+				`"slug":{"pattern":"^[a-z-]+$","type":"string"},` +
+				`"status":{"enum":["active","inactive"],"type":["string","null"]},` +
+				`"tags":{"items":{"enum":["a","b"],"type":"string"},"maxItems":5,"type":"array"},` +
+				`"tree":{"properties":{"children":{"items":{"type":"object"},"type":"array"}},"type":"object"}` +
+				`},"required":["code"],"type":"object"}`,
+		},
+		{
+			name: "test_json_schema_pointer",
+			form: &person{},
+			want: `{"$schema":"https://json-schema.org/draft/2020-12/schema","properties":{` +
+				`"name":{"description":"Full name","examples":["Daniel Silva"],"maxLength":50,"type":"string"}` +
+				`},"required":["name"],"type":"object"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(JSONSchema(tt.form))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("JSONSchema() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_Len(t *testing.T) {
+	type person struct {
+		Name *string `validations:"type=string"`
+	}
+	type createObject struct {
+		Country *string   `validations:"type=string;len=2;pattern=^[A-Z]+$;choices=ES,FR,PT"`
+		Pin     *string   `validations:"type=string;len=4"`
+		Coords  []float64 `validations:"type=[]float;len=2"`
+		Owners  []person  `validations:"type=[]struct;len=1"`
+		Tags    []string  `validations:"type=[]string;min=1;max=3;len=2"`
+	}
+	tests := []struct {
+		name     string
+		jsonData string
+		want     []error
+	}{
+		{
+			name:     "test_len",
+			jsonData: `{"country": "ES", "pin": "1234", "coords": [40.4, -3.7], "owners": [{"name": "Daniel"}], "tags": ["a", "b"]}`,
+			want:     nil,
+		},
+		{
+			name:     "test_len_errors",
+			jsonData: `{"country": "ESP", "pin": "12", "coords": [40.4], "owners": [], "tags": ["a", "b", "c"]}`,
+			want: []error{
+				ValidationError{Field: "country", Message: defaultMessage("InvalidLenString", 2), Code: "len"},
+				ValidationError{Field: "country", Message: defaultMessage("InvalidChoice", "ESP", []any{"ES", "FR", "PT"}), Code: "choice"},
+				ValidationError{Field: "pin", Message: defaultMessage("InvalidLenString", 4), Code: "len"},
+				ValidationError{Field: "coords", Message: defaultMessage("InvalidLenList", 2), Code: "len"},
+				ValidationError{Field: "owners", Message: defaultMessage("InvalidLenList", 1), Code: "len"},
+				ValidationError{Field: "tags", Message: defaultMessage("InvalidLenList", 2), Code: "len"},
+			},
+		},
+		{
+			name:     "test_len_pattern",
+			jsonData: `{"country": "es"}`,
+			want: []error{
+				ValidationError{Field: "country", Message: defaultMessage("InvalidPattern", "^[A-Z]+$"), Code: "pattern"},
+				ValidationError{Field: "country", Message: defaultMessage("InvalidChoice", "es", []any{"ES", "FR", "PT"}), Code: "choice"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate([]byte(tt.jsonData), new(createObject))
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
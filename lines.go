@@ -0,0 +1,82 @@
+package jsonValidator
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+)
+
+// LineResult is the result of a record of a JSON Lines stream: its line number, the form of type T populated with the
+// parsed data, or nil together with the errors when the record is not valid.
+type LineResult[T any] struct {
+	Line   int
+	Form   *T
+	Errors []error
+}
+
+// ValidateLines reads the newline-delimited json records (NDJSON, JSON Lines) from r one at a time, validates each of
+// them against a new form of type T and passes the result to handle, e.g. to insert the valid records of a bulk import
+// and report the others. The blank lines are skipped and the lines and columns of the errors are those of the stream.
+// With WithMaxBytes, the records bigger than the limit are reported with a PayloadTooLargeError without being buffered.
+// It stops at the end of r, returning nil, or at the first error of r or handle, returning it.
+func ValidateLines[T any](r io.Reader, handle func(result LineResult[T]) error, opts ...Option) error {
+
+	// 1) Read the records line by line.
+	o := newOptions(opts)
+	reader := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		record, tooLarge, err := readLine(reader, o.maxBytes)
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		// 2) Validate the record, skipping the blank lines.
+		if len(bytes.TrimSpace(record)) > 0 || tooLarge {
+			result := LineResult[T]{Line: line, Form: new(T)}
+			if tooLarge {
+				result.Errors = []error{PayloadTooLargeError{Limit: o.maxBytes}}
+			} else {
+				result.Errors = validate(record, result.Form, o)
+			}
+
+			// 2.1) Report the errors at the line of the stream.
+			for i, resultError := range result.Errors {
+				if validationError, ok := resultError.(ValidationError); ok && validationError.Line > 0 {
+					validationError.Line += line - 1
+					result.Errors[i] = validationError
+				}
+			}
+			if result.Errors != nil {
+				result.Form = nil
+			}
+			if handleErr := handle(result); handleErr != nil {
+				return handleErr
+			}
+		}
+
+		// 3) Stop at the end of the stream.
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// readLine reads a line without its line break, discarding the rest of the lines longer than maxBytes (when set) to
+// report them as too large.
+func readLine(reader *bufio.Reader, maxBytes int64) ([]byte, bool, error) {
+	var line []byte
+	tooLarge := false
+	for {
+		chunk, err := reader.ReadSlice('\n')
+		if !tooLarge {
+			line = append(line, chunk...)
+			if maxBytes > 0 && int64(len(bytes.TrimRight(line, "\r\n"))) > maxBytes {
+				line, tooLarge = nil, true
+			}
+		}
+		if !errors.Is(err, bufio.ErrBufferFull) {
+			return bytes.TrimRight(line, "\r\n"), tooLarge, err
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package jsonValidator
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestValidateLines(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string;required=true"`
+		Age  *int    `validations:"type=int;min=1"`
+	}
+	tests := []struct {
+		name    string
+		data    string
+		opts    []Option
+		want    []LineResult[createObject]
+		wantErr error
+	}{
+		{
+			name: "test_lines",
+			data: "{\"name\": \"Daniel\", \"age\": 26}\r\n\n{\"name\": \"Ana\", \"age\": -1}\n  {\"name\": []}\n{\"name\": \"Silva\"}",
+			want: []LineResult[createObject]{
+				{Line: 1, Form: &createObject{Name: toStringPointer("Daniel"), Age: toIntPointer(26)}},
+				{Line: 3, Errors: []error{
+					ValidationError{Field: "age", Message: defaultMessage("InvalidMinNumber", 1), Code: "min"},
+				}},
+				{Line: 4, Errors: []error{
+					ValidationError{Field: "name", Message: defaultMessage("InvalidFormat", []any{}), Code: "invalid_type", Line: 4, Column: 12},
+				}},
+				{Line: 5, Form: &createObject{Name: toStringPointer("Silva")}},
+			},
+		},
+		{
+			name: "test_lines_max_bytes",
+			data: "{\"name\": \"Daniel\"}\n{\"name\": \"" + strings.Repeat("a", 5000) + "\"}\n{\"name\": \"Silva\"}\n",
+			opts: []Option{WithMaxBytes(32)},
+			want: []LineResult[createObject]{
+				{Line: 1, Form: &createObject{Name: toStringPointer("Daniel")}},
+				{Line: 2, Errors: []error{PayloadTooLargeError{Limit: 32}}},
+				{Line: 3, Form: &createObject{Name: toStringPointer("Silva")}},
+			},
+		},
+		{
+			name:    "test_lines_handle_error",
+			data:    "{\"name\": \"Daniel\"}\n{\"name\": \"Silva\"}\n",
+			want:    []LineResult[createObject]{{Line: 1, Form: &createObject{Name: toStringPointer("Daniel")}}},
+			wantErr: errStopLines,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []LineResult[createObject]
+			err := ValidateLines(strings.NewReader(tt.data), func(result LineResult[createObject]) error {
+				sort.Sort(Errors(result.Errors))
+				got = append(got, result)
+				if tt.wantErr != nil {
+					return tt.wantErr
+				}
+				return nil
+			}, tt.opts...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateLines() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateLines() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+var errStopLines = errors.New("stop")
@@ -0,0 +1,79 @@
+package jsonValidator
+
+import (
+	"reflect"
+)
+
+// validateMap validates the members of an object against the validations of the map values and binds them into the
+// map field. Each value is validated like a field of its type, the errors being reported under its key
+// (e.g. "attributes.color").
+func (s *state) validateMap(validations *Validations, fieldName string, fieldNode int, form reflect.Value, parent string) []error {
+
+	// 1) Validate the fieldNode type.
+	if s.document.kind(fieldNode) != kindObject {
+		return []error{s.formatError(getFieldName(parent, fieldName), fieldNode)}
+	}
+	keys := s.document.keys(fieldNode)
+
+	// 2) Validate min and max keys.
+	var errors []error
+	if validations.MinKeys != 0 && len(keys) < validations.MinKeys {
+		s.trigger(getFieldName(parent, fieldName), "minKeys")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(parent, fieldName),
+			Message: s.options.format("InvalidMinKeys", getFieldName(parent, fieldName), validations.MinKeys),
+			Code:    "min_keys",
+		})
+	}
+	if validations.MaxKeys != 0 && len(keys) > validations.MaxKeys {
+		s.trigger(getFieldName(parent, fieldName), "maxKeys")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(parent, fieldName),
+			Message: s.options.format("InvalidMaxKeys", getFieldName(parent, fieldName), validations.MaxKeys),
+			Code:    "max_keys",
+		})
+	}
+	if errors != nil {
+		return errors
+	}
+
+	// 3) Validate each value in a holder struct with a pointer field of the value type.
+	field := formField(form, validations)
+	mapType := field.Type()
+	if mapType.Kind() == reflect.Pointer {
+		mapType = mapType.Elem()
+	}
+	valueType := mapType.Elem()
+	if valueType.Kind() != reflect.Pointer {
+		valueType = reflect.PointerTo(valueType)
+	}
+	holderType := reflect.StructOf([]reflect.StructField{{Name: "Value", Type: valueType}})
+	values := reflect.MakeMapWithSize(mapType, len(keys))
+	for _, key := range keys {
+		holder := reflect.New(holderType).Elem()
+		valueErrors := s.parseField(validations.Values, s.document.stringValue(key), key+1, holder, getFieldName(parent, fieldName))
+		if valueErrors != nil {
+			errors = append(errors, valueErrors...)
+			continue
+		}
+
+		// 3.1) Null values leave the value of the holder nil.
+		value := holder.Field(0)
+		if value.IsNil() {
+			continue
+		}
+		if mapType.Elem().Kind() != reflect.Pointer {
+			value = value.Elem()
+		}
+		values.SetMapIndex(reflect.ValueOf(s.document.stringValue(key)), value)
+	}
+	if errors != nil {
+		return errors
+	}
+
+	// 4) Update form with the values.
+	setField(field, values)
+
+	// 5) Return errors.
+	return nil
+}
@@ -0,0 +1,72 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_Map(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string;required=true"`
+	}
+	type createObject struct {
+		Attributes map[string]string  `validations:"type=map[string]string;maxKeys=2;max=5"`
+		Stock      map[string]int64   `validations:"type=map[string]int;minKeys=1;min=1"`
+		Owners     *map[string]Person `validations:"type=map[string]struct"`
+	}
+	name := "John"
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     *createObject
+		wantErr  []error
+	}{
+		{
+			name:     "test_maps",
+			jsonData: []byte(`{"attributes": {"color": "red", "size": "XL"}, "stock": {"madrid": "3"}, "owners": {"es": {"name": "John"}}}`),
+			want: &createObject{
+				Attributes: map[string]string{"color": "red", "size": "XL"},
+				Stock:      map[string]int64{"madrid": 3},
+				Owners:     &map[string]Person{"es": {Name: &name}},
+			},
+		},
+		{
+			name:     "test_maps_values",
+			jsonData: []byte(`{"attributes": {"color": "yellow"}, "stock": {"madrid": 0, "paris": 1}, "owners": {"es": {}}}`),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "attributes.color", Message: defaultMessage("InvalidMaxString", 5), Code: "max"},
+				ValidationError{Field: "owners.es.name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "stock.madrid", Message: defaultMessage("InvalidMinNumber", 1), Code: "min"},
+			},
+		},
+		{
+			name:     "test_maps_keys",
+			jsonData: []byte(`{"attributes": {"color": "red", "size": "XL", "fit": "slim"}, "stock": {}, "owners": []}`),
+			want:     &createObject{},
+			wantErr: []error{
+				ValidationError{Field: "attributes", Message: defaultMessage("InvalidMaxKeys", 2), Code: "max_keys"},
+				ValidationError{Field: "owners", Message: defaultMessage("InvalidFormat", []any{}), Code: "invalid_type", Line: 1, Column: 86},
+				ValidationError{Field: "stock", Message: defaultMessage("InvalidMinKeys", 1), Code: "min_keys"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := new(createObject)
+			gotErr := Validate(tt.jsonData, got)
+
+			// Sort
+			sort.Sort(Errors(gotErr))
+			sort.Sort(Errors(tt.wantErr))
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", gotErr, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() form = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package jsonValidator
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// eventHandlerPattern matches an event handler attribute ("onerror=", "onclick =") inside an opening tag, closed or
+// not ("<img src=x onerror=alert(1)>").
+var eventHandlerPattern = regexp.MustCompile(`<[a-z][^>]*[\s/"']on[a-z]+\s*=`)
+
+// hasMarkup reports whether the value has a script tag, an event handler attribute or a javascript: URL, the usual
+// payloads of the HTML injections, for the "noMarkup=true" fields that must be plain text. The value is checked after
+// decoding its HTML entities ("&lt;script", "&#106;avascript:") and ignoring the case, and the javascript: URLs after
+// removing the whitespace and control characters the browsers skip ("java\tscript:").
+// It is a heuristic, a defense in depth layer that does not replace the encoding of the output.
+func hasMarkup(value string) bool {
+	decoded := strings.ToLower(html.UnescapeString(value))
+	if strings.Contains(decoded, "<script") || eventHandlerPattern.MatchString(decoded) {
+		return true
+	}
+	compact := strings.Map(func(r rune) rune {
+		if r <= ' ' {
+			return -1
+		}
+		return r
+	}, decoded)
+	return strings.Contains(compact, "javascript:")
+}
@@ -0,0 +1,58 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_NoMarkup(t *testing.T) {
+	type createObject struct {
+		Name    *string  `validations:"type=string;noMarkup=true"`
+		Tags    []string `validations:"type=[]string;noMarkup=true"`
+		Website *string  `validations:"type=string;noMarkup=true"`
+		Comment *string  `validations:"type=string"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_plain_text",
+			jsonData: []byte("{\"name\": \"Tom & Jerry <3\", \"tags\": [\"a < b\", \"once=twice\", \"javascript\"], \"website\": \"https://example.com/onload\", \"comment\": \"<script>alert(1)</script>\"}"),
+			want:     nil,
+		},
+		{
+			name:     "test_markup",
+			jsonData: []byte("{\"name\": \"<SCRIPT src=//evil.js>\", \"tags\": [\"ok\", \"<img src=x onerror=alert(1)>\", \"<a href='#' onClick = 'x'\"], \"website\": \"java\\tscript:alert(1)\"}"),
+			want: []error{
+				ValidationError{Field: "name", Message: DefaultMessages["InvalidMarkup"], Code: "markup"},
+				ValidationError{Field: "tags[1]", Message: DefaultMessages["InvalidMarkup"], Code: "markup"},
+				ValidationError{Field: "tags[2]", Message: DefaultMessages["InvalidMarkup"], Code: "markup"},
+				ValidationError{Field: "website", Message: DefaultMessages["InvalidMarkup"], Code: "markup"},
+			},
+		},
+		{
+			name:     "test_encoded_markup",
+			jsonData: []byte("{\"name\": \"&lt;script&gt;alert(1)\", \"website\": \"&#106;avascript:alert(1)\"}"),
+			want: []error{
+				ValidationError{Field: "name", Message: DefaultMessages["InvalidMarkup"], Code: "markup"},
+				ValidationError{Field: "website", Message: DefaultMessages["InvalidMarkup"], Code: "markup"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
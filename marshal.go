@@ -0,0 +1,167 @@
+package jsonValidator
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Marshal serializes a form back into the JSON it is validated from, so Validate binds the same values again. The
+// fields are keyed like the validator reads them, the datetimes use their format= layout, the sort and filter
+// expressions are encoded back into strings, the interface fields carry their discriminator and the null fields
+// (e.g. a **string holding a nil pointer) are emitted as null. Absent fields and files are omitted. The keys of the
+// form objects are sorted, and the whole output follows RFC 8785 with the WithCanonicalJSON option.
+func Marshal(form any, opts ...Option) ([]byte, error) {
+	return marshalForm("Marshal", form, opts)
+}
+
+// marshalForm serializes a form for Marshal and Canonicalize, named by caller in the errors.
+func marshalForm(caller string, form any, opts []Option) ([]byte, error) {
+
+	// 1) Get form value.
+	formValue := reflect.ValueOf(form)
+	if formValue.Kind() == reflect.Pointer {
+		formValue = formValue.Elem()
+	}
+	if formValue.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("jsonValidator: %s expects a struct or a pointer to a struct", caller)
+	}
+
+	// 2) Convert the form and marshal it (json.Marshal sorts the keys of the maps), canonically with the
+	// WithCanonicalJSON option.
+	o := newOptions(opts)
+	jsonData, err := json.Marshal(marshalStruct(formValue, o.syntax()))
+	if err != nil || !o.jcs {
+		return jsonData, err
+	}
+	return canonicalJSON(jsonData)
+}
+
+func marshalStruct(formValue reflect.Value, syntax tagSyntax) map[string]any {
+
+	// 1) Initialize the json map.
+	object := make(map[string]any)
+
+	// 2) Iterate over the form fields that have validations.
+	for fieldName, validations := range getValidations(formValue, syntax) {
+		if validations.Type == "" || validations.Type == "file" {
+			continue
+		}
+		field := formValue.FieldByName(validations.structField)
+		if validations.index != nil {
+			var err error
+			if field, err = formValue.FieldByIndexErr(validations.index); err != nil {
+				continue
+			}
+		}
+
+		// 2.1) Absent fields are omitted, the null fields hold a nil pointer.
+		if !validations.valueField {
+			if field.IsNil() {
+				continue
+			}
+			if validations.nullField && field.Elem().IsNil() {
+				object[fieldName] = nil
+				continue
+			}
+		}
+
+		// 2.2) Convert the field according to its type.
+		object[fieldName] = marshalValue(reflect.Indirect(field), validations, syntax)
+	}
+
+	// 3) Return the json map.
+	return object
+}
+
+// marshalValue converts a bound value (dereferenced once) into the json value it is validated from.
+func marshalValue(value reflect.Value, validations *Validations, syntax tagSyntax) any {
+	switch validations.Type {
+	case "datetime":
+		layout := validations.Format
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		return reflect.Indirect(value).Interface().(time.Time).Format(layout)
+	case "string":
+		return marshalExpression(value, validations)
+	case "struct":
+		return marshalStructValue(value, validations, syntax)
+	case "[]struct":
+		elements := make([]any, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			elements[i] = marshalStructValue(value.Index(i), validations, syntax)
+		}
+		return elements
+	case "map[string]struct":
+		object := make(map[string]any, value.Len())
+		for iter := value.MapRange(); iter.Next(); {
+			object[iter.Key().String()] = marshalStructValue(iter.Value(), validations, syntax)
+		}
+		return object
+	default:
+		return value.Interface()
+	}
+}
+
+// marshalExpression encodes the terms bound from a sort or filter expression back into the expression, and the
+// decoded data URIs back into base64 data URIs, the other strings are returned as they are.
+func marshalExpression(value reflect.Value, validations *Validations) any {
+	switch terms := value.Interface().(type) {
+	case []SortTerm:
+		expression := make([]string, len(terms))
+		for i, term := range terms {
+			expression[i] = term.Field
+			if term.Descending {
+				expression[i] = "-" + term.Field
+			}
+		}
+		return strings.Join(expression, ",")
+	case []FilterTerm:
+		expression := make([]string, len(terms))
+		for i, term := range terms {
+			expression[i] = term.Field + ":" + term.Operator + ":" + term.Value
+		}
+		return strings.Join(expression, ",")
+	case DataURI:
+		return terms.String()
+	default:
+		return value.Interface()
+	}
+}
+
+// marshalStructValue converts a struct (or a pointer to it, or an interface holding one of the implementations of
+// impl=) into a json object. The implementations carry the name they are registered under in the discriminator.
+func marshalStructValue(value reflect.Value, validations *Validations, syntax tagSyntax) any {
+
+	// 1) Get the concrete struct.
+	if value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	object := marshalStruct(value, syntax)
+
+	// 2) Add the discriminator of the implementations.
+	if validations.Impl != nil {
+		discriminator := validations.Discriminator
+		if discriminator == "" {
+			discriminator = DefaultDiscriminator
+		}
+		for _, name := range validations.Impl {
+			if Implementations[name] == value.Type() {
+				object[discriminator] = name
+				break
+			}
+		}
+	}
+
+	// 3) Return the json object.
+	return object
+}
@@ -0,0 +1,107 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMarshal(t *testing.T) {
+	RegisterImplementation("card", cardPayment{})
+	RegisterImplementation("bank", new(bankPayment))
+	type Person struct {
+		Name *string `validations:"type=string"`
+		Age  *int    `validations:"type=int"`
+	}
+	type createObject struct {
+		Name     *string           `validations:"type=string"`
+		Nickname **string          `validations:"type=string;nullable=true"`
+		Count    int               `validations:"type=int"`
+		Birthday *time.Time        `validations:"type=datetime;format=2006-01-02"`
+		Sort     []SortTerm        `validations:"type=string;format=sortexpr"`
+		Filter   []FilterTerm      `validations:"type=string;format=filterexpr"`
+		Person   *Person           `validations:"type=struct"`
+		People   []Person          `validations:"type=[]struct"`
+		Owners   []*Person         `validations:"type=[]struct"`
+		Teams    map[string]Person `validations:"type=map[string]struct"`
+		Labels   map[string]string `validations:"type=map[string]string"`
+		Payment  paymentMethod     `validations:"type=struct;impl=card|bank"`
+		Location *GeoPoint         `validations:"type=geo"`
+		Price    *Money            `validations:"type=money"`
+		Scores   []float64         `validations:"type=[]float"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     string
+	}{
+		{
+			name:     "test_marshal",
+			jsonData: []byte("{\"name\": \"Daniel\", \"nickname\": null, \"count\": \"3\", \"birthday\": \"1998-03-01\", \"sort\": \"-createdAt,name\", \"filter\": \"status:eq:active,at:gte:10:30\", \"person\": {\"age\": 26}, \"people\": [{\"name\": \"Silva\"}], \"owners\": [{\"age\": 30}, null], \"teams\": {\"a\": {\"name\": \"Ana\"}}, \"labels\": {\"color\": \"red\"}, \"payment\": {\"type\": \"bank\", \"iban\": \"PT50\"}, \"location\": {\"lat\": 38.7, \"lng\": -9.1}, \"price\": {\"amount\": \"10.50\", \"currency\": \"EUR\"}, \"scores\": [1.5]}"),
+			want:     "{\"birthday\":\"1998-03-01\",\"count\":3,\"filter\":\"status:eq:active,at:gte:10:30\",\"labels\":{\"color\":\"red\"},\"location\":{\"lat\":38.7,\"lng\":-9.1},\"name\":\"Daniel\",\"nickname\":null,\"owners\":[{\"age\":30},null],\"payment\":{\"iban\":\"PT50\",\"type\":\"bank\"},\"people\":[{\"name\":\"Silva\"}],\"person\":{\"age\":26},\"price\":{\"amount\":10.50,\"currency\":\"EUR\"},\"scores\":[1.5],\"sort\":\"-createdAt,name\",\"teams\":{\"a\":{\"name\":\"Ana\"}}}",
+		},
+		{
+			name:     "test_marshal_absent_fields",
+			jsonData: []byte("{\"name\": \"Daniel\"}"),
+			want:     "{\"count\":0,\"name\":\"Daniel\"}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			if errs := Validate(tt.jsonData, form); errs != nil {
+				t.Fatalf("Validate() = %v, want nil", errs)
+			}
+			got, err := Marshal(form)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %v, want %v", string(got), tt.want)
+			}
+
+			// The marshaled json binds the same form again.
+			roundTrip := new(createObject)
+			if errs := Validate(got, roundTrip); errs != nil {
+				t.Fatalf("Validate() of the marshaled json = %v, want nil", errs)
+			}
+			if !reflect.DeepEqual(roundTrip, form) {
+				t.Errorf("Validate() of the marshaled json = %+v, want %+v", roundTrip, form)
+			}
+		})
+	}
+}
+
+func TestMarshal_SizedInts(t *testing.T) {
+	type createObject struct {
+		Code   int32    `validations:"type=int"`
+		Level  uint8    `validations:"type=int"`
+		Limit  *int16   `validations:"type=int"`
+		Counts []uint32 `validations:"type=[]int"`
+	}
+	jsonData := []byte(`{"code": -5, "level": 255, "limit": 300, "counts": [1, 2]}`)
+	want := `{"code":-5,"counts":[1,2],"level":255,"limit":300}`
+
+	form := new(createObject)
+	if errs := Validate(jsonData, form); errs != nil {
+		t.Fatalf("Validate() = %v, want nil", errs)
+	}
+	for name, marshal := range map[string]func(any, ...Option) ([]byte, error){"Marshal": Marshal, "Canonicalize": Canonicalize} {
+		got, err := marshal(form)
+		if err != nil {
+			t.Fatalf("%s() error = %v", name, err)
+		}
+		if string(got) != want {
+			t.Errorf("%s() = %s, want %s", name, got, want)
+		}
+
+		// The marshaled json binds the same form again.
+		roundTrip := new(createObject)
+		if errs := Validate(got, roundTrip); errs != nil {
+			t.Fatalf("Validate() of the %s json = %v, want nil", name, errs)
+		}
+		if !reflect.DeepEqual(roundTrip, form) {
+			t.Errorf("Validate() of the %s json = %+v, want %+v", name, roundTrip, form)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package jsonValidator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// Translator returns the messages of the validation errors, keyed like DefaultMessages, in a locale (a BCP 47 tag such
+// as "es" or "pt-BR"). It reports false when it has no message of the key in the locale, so the next locale or the
+// English default is used.
+type Translator interface {
+	Translate(locale, key string) (string, bool)
+}
+
+// Catalogs is a Translator holding a message catalog per locale. Locales can be registered while validating.
+type Catalogs struct {
+	mu       sync.RWMutex
+	catalogs map[string]map[string]string
+}
+
+// NewCatalogs returns empty catalogs.
+func NewCatalogs() *Catalogs {
+	return &Catalogs{catalogs: make(map[string]map[string]string)}
+}
+
+// Register adds the messages to the catalog of the locale, replacing the messages of the same keys.
+func (c *Catalogs) Register(locale string, messages map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	locale = canonicalLocale(locale)
+	if c.catalogs[locale] == nil {
+		c.catalogs[locale] = make(map[string]string, len(messages))
+	}
+	for key, message := range messages {
+		c.catalogs[locale][key] = message
+	}
+}
+
+// Translate returns the message of the key in the catalog of the locale.
+func (c *Catalogs) Translate(locale, key string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	message, ok := c.catalogs[canonicalLocale(locale)][key]
+	return message, ok
+}
+
+// DefaultCatalogs holds the locales registered with RegisterLocale, used when no Translator is given with
+// WithTranslator. English is the built-in DefaultMessages.
+var DefaultCatalogs = NewCatalogs()
+
+// RegisterLocale registers the messages of a locale in DefaultCatalogs, so the validations with WithLocale or
+// WithAcceptLanguage can return them.
+func RegisterLocale(locale string, messages map[string]string) {
+	DefaultCatalogs.Register(locale, messages)
+}
+
+// canonicalLocale returns the canonical form of a BCP 47 tag ("es-mx" is "es-MX"), or the locale itself when it is
+// not a valid tag.
+func canonicalLocale(locale string) string {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return locale
+	}
+	return tag.String()
+}
+
+// acceptedLocales returns the locales of an Accept-Language header, by decreasing quality.
+func acceptedLocales(header string) []string {
+	tags, _, _ := language.ParseAcceptLanguage(header)
+	locales := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		locales = append(locales, tag.String())
+	}
+	return locales
+}
+
+// placeholders holds the names of the placeholders of the messages, in the order of their values. Every message can
+// also use "{field}", the path of the invalid field, and the messages of the custom validations use "{param}".
+var placeholders = map[string][]string{
+	"InvalidFormat":            {"value"},
+	"InvalidMinString":         {"min"},
+	"InvalidMaxString":         {"max"},
+	"InvalidMinNumber":         {"min"},
+	"InvalidMaxNumber":         {"max"},
+	"InvalidMinList":           {"min"},
+	"InvalidMaxList":           {"max"},
+	"InvalidLenString":         {"len"},
+	"InvalidLenList":           {"len"},
+	"InvalidMinKeys":           {"min"},
+	"InvalidMaxKeys":           {"max"},
+	"InvalidDepth":             {"max"},
+	"InvalidMinSum":            {"member", "min"},
+	"InvalidMaxSum":            {"member", "max"},
+	"InvalidMinAverage":        {"member", "min"},
+	"InvalidMaxAverage":        {"member", "max"},
+	"UnsortedList":             {"order"},
+	"OverlappingInterval":      {"other"},
+	"InvalidPattern":           {"pattern"},
+	"InvalidPrefix":            {"prefixes"},
+	"InvalidMinDigits":         {"min"},
+	"InvalidMaxDigits":         {"max"},
+	"MismatchedSubdivision":    {"country"},
+	"MismatchedInField":        {"list"},
+	"InvalidSortExpression":    {"value"},
+	"InvalidFilterExpression":  {"value"},
+	"UnknownFieldSuggestion":   {"suggestion"},
+	"InvalidNormalization":     {"normalizer", "value"},
+	"InvalidPrecision":         {"precision"},
+	"NonFiniteNumber":          {"value"},
+	"OutOfRange":               {"min", "max"},
+	"InvalidCurrency":          {"value"},
+	"InvalidDatetime":          {"value", "format"},
+	"InvalidChoice":            {"value", "choices"},
+	"InvalidFileSize":          {"max"},
+	"InvalidMediaType":         {"value", "choices"},
+	"InvalidChoiceWithoutList": {"value"},
+	"ExcludedValue":            {"value"},
+	"TruncatedChoices":         {"choices", "count"},
+	"InvalidVersion":           {"value", "choices"},
+}
+
+// builtInMessages holds the keys of the built-in messages, the other keys are the ones of the custom validations.
+var builtInMessages = func() map[string]bool {
+	keys := make(map[string]bool, len(DefaultMessages))
+	for key := range DefaultMessages {
+		keys[key] = true
+	}
+	return keys
+}()
+
+// placeholderNames returns the names of the placeholders of a message, none for the built-in messages without values
+// and "param" for the custom validations.
+func placeholderNames(key string) []string {
+	if names, ok := placeholders[key]; ok {
+		return names
+	}
+	if builtInMessages[key] {
+		return nil
+	}
+	return []string{"param"}
+}
+
+// placeholderPattern matches the named placeholders of the messages ("{min}").
+var placeholderPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+// formatMessage replaces the "{field}" placeholder and the named placeholders of a message with the field and the
+// values, in the order of the names. The messages with positional %v verbs, as written before the named placeholders,
+// are formatted with the values in the same order.
+func formatMessage(message string, names []string, field string, values ...any) string {
+	if strings.Contains(message, "%v") {
+		if verbs := strings.Count(message, "%v"); verbs < len(values) {
+			values = values[:verbs]
+		}
+		return fmt.Sprintf(message, values...)
+	}
+	return placeholderPattern.ReplaceAllStringFunc(message, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		if name == "field" {
+			return field
+		}
+		for i, placeholderName := range names {
+			if placeholderName == name && i < len(values) {
+				return fmt.Sprint(values[i])
+			}
+		}
+		return placeholder
+	})
+}
+
+// defaultMessagesMu guards DefaultMessages, so the messages can be registered and imported while validating.
+var defaultMessagesMu sync.RWMutex
+
+// defaultMessageOf returns the message of the key in DefaultMessages.
+func defaultMessageOf(key string) string {
+	defaultMessagesMu.RLock()
+	defer defaultMessagesMu.RUnlock()
+	return DefaultMessages[key]
+}
+
+// RegisterMessage registers the message of a custom rule (e.g. a list rule) under the given key, so it is part of
+// the exported catalog and can be translated like the built-in messages. It is safe to call while validating.
+func RegisterMessage(key, message string) {
+	defaultMessagesMu.Lock()
+	defer defaultMessagesMu.Unlock()
+	DefaultMessages[key] = message
+}
+
+// ExportMessages writes the message catalog, including the registered messages of the custom rules, as a JSON
+// object keyed by the message keys.
+func ExportMessages(w io.Writer) error {
+	defaultMessagesMu.RLock()
+	defer defaultMessagesMu.RUnlock()
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(DefaultMessages)
+}
+
+// ImportMessages replaces the messages of the catalog with the ones of a translated JSON catalog, as written by
+// ExportMessages. The keys missing from the catalog keep their message. The catalog is rejected as a whole if it has
+// unknown keys, messages missing a placeholder of their key or with unknown placeholders. The messages can still use
+// positional %v verbs instead of the named placeholders, one per placeholder. It is safe to call while validating.
+func ImportMessages(r io.Reader) error {
+
+	// 1) Decode the catalog.
+	var catalog map[string]string
+	if err := json.NewDecoder(r).Decode(&catalog); err != nil {
+		return fmt.Errorf("jsonValidator: invalid message catalog: %w", err)
+	}
+
+	// 2) Check every message before replacing any, under the lock of the messages.
+	defaultMessagesMu.Lock()
+	defer defaultMessagesMu.Unlock()
+	for key, message := range catalog {
+		if _, ok := DefaultMessages[key]; !ok {
+			return fmt.Errorf("jsonValidator: unknown message %q", key)
+		}
+		if err := checkPlaceholders(key, message); err != nil {
+			return err
+		}
+	}
+
+	// 3) Replace the messages.
+	for key, message := range catalog {
+		DefaultMessages[key] = message
+	}
+	return nil
+}
+
+// checkPlaceholders checks that a message has all the placeholders of its key, as named placeholders or as %v verbs,
+// and no unknown placeholder. The messages of the keys without placeholders cannot have %v verbs, and the messages of
+// the custom validations may omit their parameter.
+func checkPlaceholders(key, message string) error {
+
+	// 1) Check the positional verbs.
+	names := placeholderNames(key)
+	builtIn := builtInMessages[key]
+	if verbs := strings.Count(message, "%v"); verbs > 0 {
+		if len(names) == 0 {
+			return fmt.Errorf("jsonValidator: message %q must not have %%v verbs", key)
+		}
+		if verbs != len(names) {
+			return fmt.Errorf("jsonValidator: message %q must have %d %%v verbs", key, len(names))
+		}
+		return nil
+	}
+
+	// 2) Check the named placeholders.
+	used := make(map[string]bool)
+	for _, match := range placeholderPattern.FindAllStringSubmatch(message, -1) {
+		if match[1] != "field" && !containsAny(toAny(names), match[1]) {
+			return fmt.Errorf("jsonValidator: message %q has an unknown placeholder {%v}", key, match[1])
+		}
+		used[match[1]] = true
+	}
+	for _, name := range names {
+		if builtIn && !used[name] {
+			return fmt.Errorf("jsonValidator: message %q must have the {%v} placeholder", key, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+package jsonValidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestExportMessages(t *testing.T) {
+	RegisterMessage("PercentagesSum", "The percentages must sum to %v.")
+	defer delete(DefaultMessages, "PercentagesSum")
+
+	var buffer bytes.Buffer
+	if err := ExportMessages(&buffer); err != nil {
+		t.Fatalf("ExportMessages() error = %v", err)
+	}
+	var catalog map[string]string
+	if err := json.Unmarshal(buffer.Bytes(), &catalog); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if len(catalog) != len(DefaultMessages) {
+		t.Errorf("ExportMessages() exported %v messages, want %v", len(catalog), len(DefaultMessages))
+	}
+	if catalog["PercentagesSum"] != "The percentages must sum to %v." {
+		t.Errorf("ExportMessages() PercentagesSum = %v", catalog["PercentagesSum"])
+	}
+}
+
+func TestImportMessages(t *testing.T) {
+	tests := []struct {
+		name     string
+		catalog  string
+		wantErr  bool
+		wantKey  string
+		wantText string
+	}{
+		{
+			name:     "test_import",
+			catalog:  `{"RequiredField": "Este campo es obligatorio.", "InvalidMinString": "Este campo debe tener al menos %v caracteres."}`,
+			wantKey:  "RequiredField",
+			wantText: "Este campo es obligatorio.",
+		},
+		{
+			name:     "test_import_unknown_key",
+			catalog:  `{"RequiredField": "Este campo es obligatorio.", "Unknown": "Desconocido."}`,
+			wantErr:  true,
+			wantKey:  "RequiredField",
+			wantText: DefaultMessages["RequiredField"],
+		},
+		{
+			name:     "test_import_missing_verb",
+			catalog:  `{"InvalidMinString": "Este campo es demasiado corto."}`,
+			wantErr:  true,
+			wantKey:  "InvalidMinString",
+			wantText: DefaultMessages["InvalidMinString"],
+		},
+		{
+			name:     "test_import_placeholders",
+			catalog:  `{"InvalidChoice": "({value}) no es válido para {field}, las opciones son ({choices})."}`,
+			wantKey:  "InvalidChoice",
+			wantText: "({value}) no es válido para {field}, las opciones son ({choices}).",
+		},
+		{
+			name:     "test_import_unknown_placeholder",
+			catalog:  `{"InvalidMinString": "Este campo debe tener al menos {max} caracteres."}`,
+			wantErr:  true,
+			wantKey:  "InvalidMinString",
+			wantText: DefaultMessages["InvalidMinString"],
+		},
+		{
+			name:     "test_import_verb_without_placeholder",
+			catalog:  `{"RequiredField": "%v es obligatorio."}`,
+			wantErr:  true,
+			wantKey:  "RequiredField",
+			wantText: DefaultMessages["RequiredField"],
+		},
+		{
+			name:     "test_import_invalid_json",
+			catalog:  `{"RequiredField": 1}`,
+			wantErr:  true,
+			wantKey:  "RequiredField",
+			wantText: DefaultMessages["RequiredField"],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := make(map[string]string, len(DefaultMessages))
+			for key, message := range DefaultMessages {
+				saved[key] = message
+			}
+			defer func() { DefaultMessages = saved }()
+
+			err := ImportMessages(strings.NewReader(tt.catalog))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ImportMessages() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := DefaultMessages[tt.wantKey]; got != tt.wantText {
+				t.Errorf("DefaultMessages[%v] = %v, want %v", tt.wantKey, got, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestValidate_Locale(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string;required=true;min=3"`
+		Age  *int    `validations:"type=int;min=18"`
+	}
+	catalogs := NewCatalogs()
+	catalogs.Register("es", map[string]string{"RequiredField": "Este campo es obligatorio."})
+	catalogs.Register("es-mx", map[string]string{"InvalidMinNumber": "Este campo debe ser mayor que %v."})
+	tests := []struct {
+		name string
+		opts []Option
+		want []string
+	}{
+		{
+			name: "test_locale_default",
+			opts: nil,
+			want: []string{DefaultMessages["RequiredField"], "This field must be bigger than 18."},
+		},
+		{
+			name: "test_locale",
+			opts: []Option{WithTranslator(catalogs), WithLocale("es")},
+			want: []string{"Este campo es obligatorio.", "This field must be bigger than 18."},
+		},
+		{
+			name: "test_locale_regional",
+			opts: []Option{WithTranslator(catalogs), WithLocale("es-MX")},
+			want: []string{"Este campo es obligatorio.", "Este campo debe ser mayor que 18."},
+		},
+		{
+			name: "test_locale_accept_language",
+			opts: []Option{WithTranslator(catalogs), WithAcceptLanguage("fr;q=0.5, es-MX, en;q=0.8")},
+			want: []string{"Este campo es obligatorio.", "Este campo debe ser mayor que 18."},
+		},
+		{
+			name: "test_locale_messages",
+			opts: []Option{WithTranslator(catalogs), WithLocale("es"), WithMessages(map[string]string{"RequiredField": "Obligatorio."})},
+			want: []string{"Obligatorio.", "This field must be bigger than 18."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := Validate([]byte(`{"age": 10}`), new(createObject), tt.opts...)
+			if len(errs) != len(tt.want) {
+				t.Fatalf("Validate() = %v, want %v", errs, tt.want)
+			}
+			for _, err := range errs {
+				validationError := err.(ValidationError)
+				want := tt.want[1]
+				if validationError.Field == "name" {
+					want = tt.want[0]
+				}
+				if validationError.Message != want {
+					t.Errorf("Validate() %v = %v, want %v", validationError.Field, validationError.Message, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	RegisterLocale("pt-BR", map[string]string{"RequiredField": "Este campo é obrigatório."})
+	defer delete(DefaultCatalogs.catalogs, "pt-BR")
+
+	type createObject struct {
+		Name *string `validations:"type=string;required=true"`
+	}
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+	request.Header.Set("Accept-Language", "pt-BR,pt;q=0.9")
+	errs := ValidateRequest(request, nil, new(createObject))
+	if len(errs) != 1 || errs[0].(ValidationError).Message != "Este campo é obrigatório." {
+		t.Errorf("ValidateRequest() = %v", errs)
+	}
+}
+
+func TestRegisterMessage_Concurrent(t *testing.T) {
+	defer func(message string) { RegisterMessage("RequiredField", message) }(DefaultMessages["RequiredField"])
+	defer func() {
+		defaultMessagesMu.Lock()
+		delete(DefaultMessages, "concurrent")
+		defaultMessagesMu.Unlock()
+	}()
+	type createObject struct {
+		Name *string `validations:"type=string;required=true"`
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			RegisterMessage("concurrent", "This field is concurrent.")
+		}()
+		go func() {
+			defer wg.Done()
+			if err := ImportMessages(strings.NewReader(`{"RequiredField": "This field is required."}`)); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if errs := Validate([]byte(`{}`), new(createObject)); len(errs) != 1 {
+				t.Errorf("Validate() = %v", errs)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestValidate_MessagePlaceholders(t *testing.T) {
+	type createObject struct {
+		Name   *string `validations:"type=string;min=3"`
+		Status *string `validations:"type=string;choices=a,b"`
+		Age    *int    `validations:"type=int;min=18"`
+	}
+	messages := map[string]string{
+		"InvalidMinString": "{field} debe tener al menos {min} caracteres.",
+		"InvalidChoice":    "Las opciones de {field} son ({choices}), no ({value}).",
+		"InvalidMinNumber": "This field must be bigger than %v.",
+	}
+	got := Validate([]byte(`{"name": "ab", "status": "c", "age": 10}`), new(createObject), WithMessages(messages))
+	want := []error{
+		ValidationError{Field: "age", Message: "This field must be bigger than 18.", Code: "min"},
+		ValidationError{Field: "name", Message: "name debe tener al menos 3 caracteres.", Code: "min"},
+		ValidationError{Field: "status", Message: "Las opciones de status son ([a b]), no (c).", Code: "choice"},
+	}
+	sort.Sort(Errors(got))
+	sort.Sort(Errors(want))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,269 @@
+package jsonValidator
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Money is the value bound into the "type=money" fields, from a {"amount": 12.34, "currency": "EUR"} object or a
+// "12.34 EUR" string. The amount is kept exactly, as an integer number of minor units of its scale: 12.34 EUR is bound
+// as {Amount: 1234, Scale: 2, Currency: "EUR"}.
+type Money struct {
+	Amount   int64
+	Scale    int
+	Currency string
+}
+
+// Decimal returns the amount as a decimal number with Scale decimals, e.g. "12.34".
+func (m Money) Decimal() string {
+	units := uint64(m.Amount)
+	if m.Amount < 0 {
+		units = -units
+	}
+	digits := strconv.FormatUint(units, 10)
+	if len(digits) <= m.Scale {
+		digits = strings.Repeat("0", m.Scale-len(digits)+1) + digits
+	}
+	if m.Scale > 0 {
+		digits = digits[:len(digits)-m.Scale] + "." + digits[len(digits)-m.Scale:]
+	}
+	if m.Amount < 0 {
+		digits = "-" + digits
+	}
+	return digits
+}
+
+// Float64 returns the amount as a float64, which may not be exact.
+func (m Money) Float64() float64 {
+	return float64(m.Amount) / math.Pow10(m.Scale)
+}
+
+// String returns the money the way it is received as a string, e.g. "12.34 EUR".
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+// MarshalJSON encodes the money as a {"amount": 12.34, "currency": "EUR"} object, with the exact decimal amount.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Amount   json.Number `json:"amount"`
+		Currency string      `json:"currency"`
+	}{Amount: json.Number(m.Decimal()), Currency: m.Currency})
+}
+
+// CurrencyScales holds the number of decimals of the currencies that do not have 2 decimals (ISO 4217 minor units),
+// used when the money fields do not declare a "scale=".
+var CurrencyScales = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0,
+	"UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+func (s *state) validateMoney(validations *Validations, fieldName string, fieldNode int, form reflect.Value, parent string) []error {
+
+	// 1) Get the amount, as written, and the currency from the object or the string, null is only accepted by the
+	// nullable fields.
+	field := getFieldName(parent, fieldName)
+	var money Money
+	var amount string
+	var errors []error
+	switch s.document.kind(fieldNode) {
+	case kindString:
+		var currency string
+		var found bool
+		amount, currency, found = strings.Cut(strings.TrimSpace(s.document.stringValue(fieldNode)), " ")
+		parsed, err := strconv.ParseFloat(amount, 64)
+		if !found || err != nil {
+			return []error{s.formatError(field, fieldNode)}
+		}
+		if errors := s.nonFiniteErrors(field, fieldNode, parsed); errors != nil {
+			return errors
+		}
+		money.Currency = strings.TrimSpace(currency)
+	case kindObject:
+		amount, money.Currency, errors = s.moneyMembers(validations, field, fieldNode)
+		if errors != nil {
+			return errors
+		}
+	default:
+		return []error{s.formatError(field, fieldNode)}
+	}
+
+	// 2) Validate the currency.
+	if len(money.Currency) != 3 || strings.ToUpper(money.Currency) != money.Currency || strings.Trim(money.Currency, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		s.trigger(field, "type")
+		return []error{ValidationError{
+			Field:   field,
+			Message: s.options.format("InvalidCurrency", field, money.Currency),
+			Code:    "currency",
+		}}
+	}
+	if validations.Currencies != nil && !containsAny(toAny(validations.Currencies), money.Currency) {
+		s.trigger(field, "currencies")
+		return []error{ValidationError{
+			Field:   field,
+			Message: s.choiceMessage(field, money.Currency, &Validations{Choices: toAny(validations.Currencies)}),
+			Code:    "choice",
+		}}
+	}
+
+	// 3) Validate the scale of the amount, declared or from the currency.
+	scale := 2
+	if currencyScale, ok := CurrencyScales[money.Currency]; ok {
+		scale = currencyScale
+	}
+	if validations.Scale >= 0 {
+		scale = validations.Scale
+	}
+	units, exact, inRange := minorUnits(amount, scale)
+	if !inRange {
+		return []error{s.formatError(field, fieldNode)}
+	}
+	if !exact {
+		s.trigger(field, "scale")
+		return []error{ValidationError{
+			Field:   field,
+			Message: s.options.format("InvalidPrecision", field, scale),
+			Code:    "precision",
+		}}
+	}
+	money.Amount, money.Scale = units, scale
+
+	// 4) Validate min and max.
+	if !reflect.ValueOf(validations.Min).IsZero() && money.Float64() < validations.Min {
+		s.trigger(field, "min")
+		errors = append(errors, ValidationError{
+			Field:   field,
+			Message: s.options.format("InvalidMinNumber", field, validations.Min),
+			Code:    "min",
+		})
+	}
+	if !reflect.ValueOf(validations.Max).IsZero() && money.Float64() > validations.Max {
+		s.trigger(field, "max")
+		errors = append(errors, ValidationError{
+			Field:   field,
+			Message: s.options.format("InvalidMaxNumber", field, validations.Max),
+			Code:    "max",
+		})
+	}
+	if errors != nil {
+		return errors
+	}
+
+	// 5) Validate the custom rules.
+	if customErrors := s.validateCustom(validations, field, money); customErrors != nil {
+		return customErrors
+	}
+
+	// 6) Update form with the parsed money.
+	setValue(form, validations, &money)
+
+	// 7) Return errors.
+	return nil
+}
+
+// moneyMembers returns the amount, as written, and the currency of a {"amount": ..., "currency": ...} object.
+func (s *state) moneyMembers(validations *Validations, field string, fieldNode int) (string, string, []error) {
+
+	// 1) Reject the unknown members, unless allowed.
+	var errors []error
+	for keyNode := s.document.nodes[fieldNode].first; keyNode != 0; keyNode = s.document.nodes[keyNode].next {
+		if name := s.document.stringValue(keyNode); name != "amount" && name != "currency" && !s.options.allowUnknownFields {
+			errors = append(errors, ValidationError{
+				Field:   getFieldName(field, name),
+				Message: s.options.format("InvalidField", getFieldName(field, name)),
+				Code:    "unknown_field",
+			})
+		}
+	}
+
+	// 2) Validate the amount.
+	var amount, currency string
+	amountNode := s.document.member(fieldNode, "amount")
+	if amountNode < 0 {
+		s.trigger(field, "type")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(field, "amount"),
+			Message: s.options.format("RequiredField", getFieldName(field, "amount")),
+			Code:    "required",
+		})
+	} else if parsed, invalidFormat := validateFloatType(s.document, amountNode); invalidFormat || s.strictRejects(validations, amountNode, "float") {
+		errors = append(errors, s.formatError(getFieldName(field, "amount"), amountNode))
+	} else if nonFiniteErrors := s.nonFiniteErrors(getFieldName(field, "amount"), amountNode, *parsed); nonFiniteErrors != nil {
+		errors = append(errors, nonFiniteErrors...)
+	} else {
+		s.recordCoercion(getFieldName(field, "amount"), amountNode, "float")
+		amount = string(s.document.raw(amountNode))
+		if s.document.kind(amountNode) == kindString {
+			amount = s.document.stringValue(amountNode)
+		}
+	}
+
+	// 3) Validate the currency.
+	currencyNode := s.document.member(fieldNode, "currency")
+	switch {
+	case currencyNode < 0:
+		s.trigger(field, "type")
+		errors = append(errors, ValidationError{
+			Field:   getFieldName(field, "currency"),
+			Message: s.options.format("RequiredField", getFieldName(field, "currency")),
+			Code:    "required",
+		})
+	case s.document.kind(currencyNode) != kindString:
+		errors = append(errors, s.formatError(getFieldName(field, "currency"), currencyNode))
+	default:
+		currency = s.document.stringValue(currencyNode)
+	}
+
+	// 4) Return the amount and the currency.
+	return amount, currency, errors
+}
+
+// minorUnits returns the amount (a decimal number, with an optional exponent) in minor units of the scale, whether it
+// has at most scale decimals, and whether it fits in an int64. The amount is read from its digits, never as a float.
+func minorUnits(amount string, scale int) (int64, bool, bool) {
+
+	// 1) Split the sign, the digits and the exponent, which shifts the decimal point.
+	mantissa, exponent, hasExponent := strings.Cut(strings.ToLower(amount), "e")
+	sign := ""
+	if strings.HasPrefix(mantissa, "-") || strings.HasPrefix(mantissa, "+") {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	integer, fraction, _ := strings.Cut(mantissa, ".")
+	digits, shift := integer+fraction, scale-len(fraction)
+	if hasExponent {
+		power, err := strconv.Atoi(exponent)
+		if err != nil {
+			return 0, false, false
+		}
+		shift += power
+	}
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return 0, false, false
+	}
+
+	// 2) Drop the decimals below the scale, which must be zeros.
+	if shift < 0 {
+		cut := len(digits) + shift
+		if cut < 0 {
+			cut = 0
+		}
+		if strings.Trim(digits[cut:], "0") != "" {
+			return 0, false, true
+		}
+		digits, shift = digits[:cut], 0
+	}
+
+	// 3) Add the zeros up to the scale, and parse the minor units.
+	if digits = strings.TrimLeft(digits, "0"); digits == "" {
+		return 0, true, true
+	}
+	if len(digits)+shift > 19 {
+		return 0, true, false
+	}
+	units, err := strconv.ParseInt(sign+digits+strings.Repeat("0", shift), 10, 64)
+	return units, true, err == nil
+}
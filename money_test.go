@@ -0,0 +1,128 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_Money(t *testing.T) {
+	type createObject struct {
+		Price    *Money `validations:"type=money;required=true;min=0.01;currencies=EUR,USD,JPY"`
+		Discount *Money `validations:"type=money;scale=0"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+		wantForm *createObject
+	}{
+		{
+			name:     "test_money",
+			jsonData: []byte(`{"price": {"amount": 12.34, "currency": "EUR"}, "discount": "5 GBP"}`),
+			want:     nil,
+			wantForm: &createObject{Price: &Money{Amount: 1234, Scale: 2, Currency: "EUR"}, Discount: &Money{Amount: 5, Currency: "GBP"}},
+		},
+		{
+			name:     "test_money_string",
+			jsonData: []byte(`{"price": "1500 JPY"}`),
+			want:     nil,
+			wantForm: &createObject{Price: &Money{Amount: 1500, Currency: "JPY"}},
+		},
+		{
+			name:     "test_money_exact",
+			jsonData: []byte(`{"price": {"amount": 90071992547409.93, "currency": "USD"}, "discount": {"amount": "1.5e3", "currency": "EUR"}}`),
+			want:     nil,
+			wantForm: &createObject{Price: &Money{Amount: 9007199254740993, Scale: 2, Currency: "USD"}, Discount: &Money{Amount: 1500, Currency: "EUR"}},
+		},
+		{
+			name:     "test_money_trailing_zeros",
+			jsonData: []byte(`{"price": "12.3400 EUR", "discount": {"amount": 0.5e1, "currency": "EUR"}}`),
+			want:     nil,
+			wantForm: &createObject{Price: &Money{Amount: 1234, Scale: 2, Currency: "EUR"}, Discount: &Money{Amount: 5, Currency: "EUR"}},
+		},
+		{
+			name:     "test_money_missing_members",
+			jsonData: []byte(`{"price": {"amount": 12.34, "tax": 1}, "discount": {"currency": "EUR"}}`),
+			want: []error{
+				ValidationError{Field: "discount.amount", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "price.currency", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "price.tax", Message: DefaultMessages["InvalidField"], Code: "unknown_field"},
+			},
+			wantForm: &createObject{},
+		},
+		{
+			name:     "test_money_invalid",
+			jsonData: []byte(`{"price": {"amount": 12.345, "currency": "EUR"}, "discount": "1.5 EUR"}`),
+			want: []error{
+				ValidationError{Field: "discount", Message: defaultMessage("InvalidPrecision", 0), Code: "precision"},
+				ValidationError{Field: "price", Message: defaultMessage("InvalidPrecision", 2), Code: "precision"},
+			},
+			wantForm: &createObject{},
+		},
+		{
+			name:     "test_money_scale_of_the_currency",
+			jsonData: []byte(`{"price": "12.5 JPY"}`),
+			want: []error{
+				ValidationError{Field: "price", Message: defaultMessage("InvalidPrecision", 0), Code: "precision"},
+			},
+			wantForm: &createObject{},
+		},
+		{
+			name:     "test_money_null_and_out_of_range",
+			jsonData: []byte(`{"price": null, "discount": "92233720368547758080 EUR"}`),
+			want: []error{
+				ValidationError{Field: "discount", Message: defaultMessage("InvalidFormat", "92233720368547758080 EUR"), Code: "invalid_type", Line: 1, Column: 29},
+				ValidationError{Field: "price", Message: defaultMessage("InvalidFormat", nil), Code: "invalid_type", Line: 1, Column: 11},
+			},
+			wantForm: &createObject{},
+		},
+		{
+			name:     "test_money_currency",
+			jsonData: []byte(`{"price": "12 GBP", "discount": "1 eur"}`),
+			want: []error{
+				ValidationError{Field: "discount", Message: defaultMessage("InvalidCurrency", "eur"), Code: "currency"},
+				ValidationError{Field: "price", Message: defaultMessage("InvalidChoice", "GBP", []any{"EUR", "USD", "JPY"}), Code: "choice"},
+			},
+			wantForm: &createObject{},
+		},
+		{
+			name:     "test_money_min_and_format",
+			jsonData: []byte(`{"price": "0 EUR", "discount": "12.34"}`),
+			want: []error{
+				ValidationError{Field: "discount", Message: defaultMessage("InvalidFormat", "12.34"), Code: "invalid_type", Line: 1, Column: 32},
+				ValidationError{Field: "price", Message: defaultMessage("InvalidMinNumber", 0.01), Code: "min"},
+			},
+			wantForm: &createObject{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := Validate(tt.jsonData, form)
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %#v, want %#v", got, tt.want)
+			}
+			if !reflect.DeepEqual(form, tt.wantForm) {
+				t.Errorf("Validate() form = %+v, want %+v", form, tt.wantForm)
+			}
+		})
+	}
+}
+
+func TestMoney_Decimal(t *testing.T) {
+	tests := []struct {
+		money Money
+		want  string
+	}{
+		{money: Money{Amount: 1234, Scale: 2, Currency: "EUR"}, want: "12.34 EUR"},
+		{money: Money{Amount: -5, Scale: 3, Currency: "KWD"}, want: "-0.005 KWD"},
+		{money: Money{Amount: 1500, Currency: "JPY"}, want: "1500 JPY"},
+	}
+	for _, tt := range tests {
+		if got := tt.money.String(); got != tt.want {
+			t.Errorf("String() = %v, want %v", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package jsonValidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// ValidateMultipart validates a multipart/form-data body against the form and update the form with the parsed data.
+// The value parts are validated with the same rules as json fields (coercing them from strings), and the file parts
+// are streamed into the io.Reader fields declared with "type=file". A file part exceeding its "maxBytes" aborts the
+// validation as soon as the limit is crossed, without reading the rest of the upload. The files are kept in memory up
+// to 32 MB and the bigger ones spooled to a temporary file, removed when the io.Reader of the field is closed (it is
+// an io.Closer then), or as soon as the validation fails. The *multipart.FileHeader fields need the parsed body, see
+// ValidateMultipartForm.
+func ValidateMultipart(r *multipart.Reader, form any, opts ...Option) (errors []error) {
+
+	// 1) Apply the options.
+	o := newOptions(opts)
+
+	// 2) Get form value and its validations.
+	formValue, err := formValueOf(form)
+	if err != nil {
+		return []error{err}
+	}
+	validationsMap := getValidations(formValue, o.syntax())
+
+	// 3) Iterate over the parts, the spooled files being removed when the validation fails.
+	var spooled []reflect.Value
+	defer func() {
+		if errors == nil {
+			return
+		}
+		for _, field := range spooled {
+			field.Interface().(io.Closer).Close()
+			field.Set(reflect.Zero(field.Type()))
+		}
+	}()
+	values := make(map[string][]string)
+	body := &maxBytesReader{remaining: o.maxBytes, limit: o.maxBytes}
+	for {
+		part, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return []error{ValidationError{
+				Field:   "multipart",
+				Message: o.format("InvalidFormat", "multipart", err),
+				Code:    "invalid_multipart",
+			}}
+		}
+		name := part.FormName()
+
+		// 3.1) Limit the size of the whole body.
+		var reader io.Reader = part
+		if o.maxBytes > 0 {
+			body.r = part
+			reader = body
+		}
+
+		// 3.2) Stream the file parts into the form, after checking their media type.
+		if validations, ok := validationsMap[name]; ok && validations.Type == "file" {
+			fieldName := getFieldName(o.pathPrefix, name)
+			field := formField(formValue, validations)
+			if !readerType.AssignableTo(field.Type()) {
+				return []error{ConfigError{Message: fmt.Sprintf("the file field %s must be an io.Reader to be streamed, got %s", fieldName, field.Type())}}
+			}
+			if err := fileMediaTypeError(fieldName, part.Header, validations, o); err != nil {
+				return []error{err}
+			}
+			content, tooLarge, err := readFilePart(reader, validations.MaxBytes)
+			if err != nil {
+				return []error{err}
+			}
+			if tooLarge {
+				if o.ruleCoverage != nil {
+					o.ruleCoverage.record(fieldName, "maxBytes")
+				}
+				return []error{ValidationError{
+					Field:   fieldName,
+					Message: o.format("InvalidFileSize", fieldName, validations.MaxBytes),
+					Code:    "max_bytes",
+				}}
+			}
+			field.Set(reflect.ValueOf(content))
+			if _, ok := content.(spooledFile); ok {
+				spooled = append(spooled, field)
+			}
+			values[name] = nil
+			continue
+		}
+
+		// 3.3) Collect the value parts.
+		value, err := io.ReadAll(reader)
+		if _, ok := err.(PayloadTooLargeError); ok {
+			return []error{err}
+		}
+		values[name] = append(values[name], string(value))
+	}
+
+	// 4) Validate the values as a json object, the size of the body was already checked while reading it.
+	o.maxBytes = 0
+	o.fromValues = true
+	return validate(valuesToJson(values, validationsMap), form, o)
+}
+
+// ValidateMultipartForm validates a parsed multipart/form-data body (e.g. r.MultipartForm after r.ParseMultipartForm)
+// against the form and update the form with the parsed data. The values are validated like ValidateMultipart, and the
+// first file of each "type=file" field is checked against its "maxBytes" and "mediaTypes" (declared Content-Type of
+// the part) and set into the field, a *multipart.FileHeader or an io.Reader reading the file. The files opened for the
+// io.Reader fields are closed, and the fields unset, when the validation fails.
+func ValidateMultipartForm(mf *multipart.Form, form any, opts ...Option) []error {
+
+	// 1) Get form value and its validations.
+	formValue, err := formValueOf(form)
+	if err != nil {
+		return []error{err}
+	}
+	o := newOptions(opts)
+	errors := validateMultipartForm(mf, formValue, getValidations(formValue, o.syntax()), o)
+
+	// 2) Audit the validation, without the content of the files.
+	o.audit(formValue.Type(), nil, errors)
+	return errors
+}
+
+func validateMultipartForm(mf *multipart.Form, formValue reflect.Value, validationsMap map[string]*Validations, o *options) (errors []error) {
+
+	// 1) Collect the values, the size of the body was already checked while parsing it.
+	values := make(map[string][]string, len(mf.Value))
+	for name, fieldValues := range mf.Value {
+		values[name] = fieldValues
+	}
+
+	// 2) Check and set the files, the opened files being closed when the validation fails.
+	var opened []reflect.Value
+	defer func() {
+		if errors == nil {
+			return
+		}
+		for _, field := range opened {
+			field.Interface().(io.Closer).Close()
+			field.Set(reflect.Zero(field.Type()))
+		}
+	}()
+	for name, fileHeaders := range mf.File {
+		values[name] = nil
+		validations, ok := validationsMap[name]
+		if !ok || validations.Type != "file" || len(fileHeaders) == 0 {
+			continue
+		}
+		fileHeader := fileHeaders[0]
+		fieldName := getFieldName(o.pathPrefix, name)
+
+		// 2.1) Check the media type and the size.
+		if err := fileMediaTypeError(fieldName, fileHeader.Header, validations, o); err != nil {
+			errors = append(errors, err)
+			continue
+		}
+		if validations.MaxBytes > 0 && fileHeader.Size > validations.MaxBytes {
+			if o.ruleCoverage != nil {
+				o.ruleCoverage.record(fieldName, "maxBytes")
+			}
+			errors = append(errors, ValidationError{
+				Field:   fieldName,
+				Message: o.format("InvalidFileSize", fieldName, validations.MaxBytes),
+				Code:    "max_bytes",
+			})
+			continue
+		}
+
+		// 2.2) Set the file header, or the opened file.
+		field := formField(formValue, validations)
+		switch {
+		case fileHeaderType.AssignableTo(field.Type()):
+			field.Set(reflect.ValueOf(fileHeader))
+		case readerType.AssignableTo(field.Type()):
+			file, err := fileHeader.Open()
+			if err != nil {
+				return []error{err}
+			}
+			field.Set(reflect.ValueOf(file))
+			opened = append(opened, field)
+		default:
+			return []error{ConfigError{Message: fmt.Sprintf("the file field %s must be a *multipart.FileHeader or an io.Reader, got %s", name, field.Type())}}
+		}
+	}
+
+	// 3) Validate the values as a json object.
+	o.maxBytes = 0
+	o.fromValues = true
+	return append(errors, validateForm(valuesToJson(values, validationsMap), formValue, validationsMap, o)...)
+}
+
+// multipartMemory is the memory used to parse the multipart/form-data requests and to stream each file part, the
+// bigger files are stored on disk.
+var multipartMemory int64 = 32 << 20
+
+// validateMultipartBody parses the multipart/form-data body of the request into r.MultipartForm, so the server removes
+// its files after the handler, and validates it.
+func validateMultipartBody(r *http.Request, boundary string, formValue reflect.Value, validationsMap map[string]*Validations, o *options) []error {
+
+	// 1) Limit the size of the body.
+	var body io.Reader = r.Body
+	if o.maxBytes > 0 {
+		body = &maxBytesReader{r: r.Body, remaining: o.maxBytes, limit: o.maxBytes}
+	}
+
+	// 2) Parse the body.
+	mf, err := multipart.NewReader(body, boundary).ReadForm(multipartMemory)
+	if err != nil {
+		var payloadTooLargeError PayloadTooLargeError
+		if errors.As(err, &payloadTooLargeError) {
+			return []error{payloadTooLargeError}
+		}
+		return []error{ValidationError{
+			Field:   getFieldName(o.pathPrefix, "multipart"),
+			Message: o.format("InvalidFormat", "multipart", err),
+			Code:    "invalid_multipart",
+		}}
+	}
+	r.MultipartForm = mf
+
+	// 3) Validate the values and the files.
+	return validateMultipartForm(mf, formValue, validationsMap, o)
+}
+
+var (
+	readerType     = reflect.TypeOf((*io.Reader)(nil)).Elem()
+	fileHeaderType = reflect.TypeOf((*multipart.FileHeader)(nil))
+)
+
+// fileMediaTypeError checks the declared Content-Type of a file part against its "mediaTypes".
+func fileMediaTypeError(fieldName string, header textproto.MIMEHeader, validations *Validations, o *options) error {
+	if validations.MediaTypes == nil {
+		return nil
+	}
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	if containsAny(toAny(validations.MediaTypes), mediaType) {
+		return nil
+	}
+	if o.ruleCoverage != nil {
+		o.ruleCoverage.record(fieldName, "mediaTypes")
+	}
+	return ValidationError{
+		Field:   fieldName,
+		Message: o.format("InvalidMediaType", fieldName, mediaType, validations.MediaTypes),
+		Code:    "media_type",
+	}
+}
+
+// spooledFile is a file part spooled to a temporary file, which is removed when closed.
+type spooledFile struct {
+	*os.File
+}
+
+func (f spooledFile) Close() error {
+	err := f.File.Close()
+	if removeErr := os.Remove(f.Name()); err == nil {
+		err = removeErr
+	}
+	return err
+}
+
+// readFilePart reads a file part, stopping as soon as it exceeds maxBytes (when bigger than 0). The file is read in
+// memory up to multipartMemory, and the bigger ones spooled to a temporary file.
+func readFilePart(part io.Reader, maxBytes int64) (io.Reader, bool, error) {
+
+	// 1) Read the file in memory.
+	if maxBytes > 0 {
+		part = io.LimitReader(part, maxBytes+1)
+	}
+	var content bytes.Buffer
+	size, err := io.CopyN(&content, part, multipartMemory+1)
+	if err != nil && err != io.EOF {
+		return nil, false, err
+	}
+	if maxBytes > 0 && size > maxBytes {
+		return nil, true, nil
+	}
+	if size <= multipartMemory {
+		return bytes.NewReader(content.Bytes()), false, nil
+	}
+
+	// 2) Spool the bigger files to a temporary file, removed on the errors.
+	file, err := os.CreateTemp("", "multipart-")
+	if err != nil {
+		return nil, false, err
+	}
+	spooled := spooledFile{file}
+	if size, err = io.Copy(file, io.MultiReader(&content, part)); err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil || maxBytes > 0 && size > maxBytes {
+		spooled.Close()
+		return nil, err == nil, err
+	}
+	return spooled, false, nil
+}
+
+// valuesToJson converts string values (form values, query strings, headers...) into a json object. The list
+// fields receive all the values, the other fields receive the first one.
+func valuesToJson(values map[string][]string, validationsMap map[string]*Validations) []byte {
+	object := make(map[string]any, len(values))
+	for name, fieldValues := range values {
+		validations, ok := validationsMap[name]
+		switch {
+		case ok && strings.HasPrefix(validations.Type, "[]"):
+			object[name] = fieldValues
+		case len(fieldValues) > 0:
+			object[name] = fieldValues[0]
+		default:
+			object[name] = nil
+		}
+	}
+	jsonData, _ := json.Marshal(object)
+	return jsonData
+}
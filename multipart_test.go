@@ -0,0 +1,427 @@
+package jsonValidator
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func newMultipartReader(t *testing.T, values map[string][]string, files map[string]string) *multipart.Reader {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	for name, fieldValues := range values {
+		for _, value := range fieldValues {
+			if err := writer.WriteField(name, value); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for name, content := range files {
+		part, err := writer.CreateFormFile(name, name+".txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = part.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return multipart.NewReader(body, writer.Boundary())
+}
+
+func TestValidateMultipart(t *testing.T) {
+	type createObject struct {
+		Name   *string   `validations:"type=string;required=true"`
+		Code   *int      `validations:"type=int"`
+		Owners []string  `validations:"type=[]string"`
+		Avatar io.Reader `validations:"type=file;required=true;maxBytes=10"`
+	}
+	tests := []struct {
+		name        string
+		values      map[string][]string
+		files       map[string]string
+		wantErrors  []error
+		wantContent string
+	}{
+		{
+			name:        "test_multipart",
+			values:      map[string][]string{"name": {"Daniel"}, "code": {"123"}, "owners": {"Daniel", "Silva"}},
+			files:       map[string]string{"avatar": "0123456789"},
+			wantErrors:  nil,
+			wantContent: "0123456789",
+		},
+		{
+			name:   "test_multipart_errors",
+			values: map[string][]string{"code": {"Daniel"}, "surname": {"Silva"}},
+			files:  nil,
+			wantErrors: []error{
+				ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "avatar", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "surname", Message: DefaultMessages["InvalidField"], Code: "unknown_field"},
+				ValidationError{Field: "code", Message: defaultMessage("InvalidFormat", "Daniel"), Code: "invalid_type"},
+			},
+		},
+		{
+			name:       "test_multipart_file_too_large",
+			values:     map[string][]string{"name": {"Daniel"}},
+			files:      map[string]string{"avatar": strings.Repeat("0", 1000)},
+			wantErrors: []error{ValidationError{Field: "avatar", Message: defaultMessage("InvalidFileSize", 10), Code: "max_bytes"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := ValidateMultipart(newMultipartReader(t, tt.values, tt.files), form)
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.wantErrors))
+
+			if !reflect.DeepEqual(got, tt.wantErrors) {
+				t.Errorf("ValidateMultipart() = %v, want %v", got, tt.wantErrors)
+			}
+			if tt.wantContent != "" {
+				content, _ := io.ReadAll(form.Avatar)
+				if string(content) != tt.wantContent {
+					t.Errorf("ValidateMultipart() = %v, want %v", string(content), tt.wantContent)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateMultipart_MaxBytes(t *testing.T) {
+	type createObject struct {
+		Name   *string   `validations:"type=string"`
+		Avatar io.Reader `validations:"type=file"`
+	}
+	tests := []struct {
+		name   string
+		values map[string][]string
+		files  map[string]string
+		want   []error
+	}{
+		{
+			name:   "test_max_bytes",
+			values: map[string][]string{"name": {"Daniel"}},
+			files:  map[string]string{"avatar": "0123456789"},
+			want:   nil,
+		},
+		{
+			name:   "test_max_bytes_value_error",
+			values: map[string][]string{"name": {strings.Repeat("a", 100)}},
+			want:   []error{PayloadTooLargeError{Limit: 20}},
+		},
+		{
+			name:   "test_max_bytes_file_error",
+			values: map[string][]string{"name": {"Daniel"}},
+			files:  map[string]string{"avatar": strings.Repeat("0", 15)},
+			want:   []error{PayloadTooLargeError{Limit: 20}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateMultipart(newMultipartReader(t, tt.values, tt.files), new(createObject), WithMaxBytes(20))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateMultipart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateMultipart_Spooled(t *testing.T) {
+	type createObject struct {
+		Avatar io.Reader `validations:"type=file;maxBytes=30"`
+	}
+	defer func(memory int64) { multipartMemory = memory }(multipartMemory)
+	multipartMemory = 10
+	tests := []struct {
+		name        string
+		content     string
+		opts        []Option
+		wantErrors  []error
+		wantSpooled bool
+	}{
+		{
+			name:    "test_spooled_in_memory",
+			content: "0123456789",
+		},
+		{
+			name:        "test_spooled_file",
+			content:     strings.Repeat("0", 20),
+			wantSpooled: true,
+		},
+		{
+			name:       "test_spooled_file_too_large",
+			content:    strings.Repeat("0", 40),
+			opts:       []Option{WithPathPrefix("upload")},
+			wantErrors: []error{ValidationError{Field: "upload.avatar", Message: defaultMessage("InvalidFileSize", 30), Code: "max_bytes"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := ValidateMultipart(newMultipartReader(t, nil, map[string]string{"avatar": tt.content}), form, tt.opts...)
+			if !reflect.DeepEqual(got, tt.wantErrors) {
+				t.Fatalf("ValidateMultipart() = %v, want %v", got, tt.wantErrors)
+			}
+			if tt.wantErrors != nil {
+				return
+			}
+			if content, _ := io.ReadAll(form.Avatar); string(content) != tt.content {
+				t.Errorf("ValidateMultipart() = %v, want %v", string(content), tt.content)
+			}
+			file, spooled := form.Avatar.(spooledFile)
+			if spooled != tt.wantSpooled {
+				t.Fatalf("ValidateMultipart() spooled = %v, want %v", spooled, tt.wantSpooled)
+			}
+			if spooled {
+				if err := file.Close(); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := os.Stat(file.Name()); !os.IsNotExist(err) {
+					t.Errorf("ValidateMultipart() spooled file %s was not removed", file.Name())
+				}
+			}
+		})
+	}
+}
+
+func TestValidateMultipart_SpooledRemovedOnErrors(t *testing.T) {
+	type createObject struct {
+		Name   *string   `validations:"type=string;required=true"`
+		Avatar io.Reader `validations:"type=file"`
+	}
+	defer func(memory int64) { multipartMemory = memory }(multipartMemory)
+	multipartMemory = 10
+	tempDir := t.TempDir()
+	t.Setenv("TMPDIR", tempDir)
+
+	form := new(createObject)
+	got := ValidateMultipart(newMultipartReader(t, nil, map[string]string{"avatar": strings.Repeat("0", 20)}), form)
+	want := []error{ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ValidateMultipart() = %v, want %v", got, want)
+	}
+	if form.Avatar != nil {
+		t.Errorf("ValidateMultipart() avatar = %v, want nil", form.Avatar)
+	}
+	if entries, _ := os.ReadDir(tempDir); len(entries) != 0 {
+		t.Errorf("ValidateMultipart() left %d spooled files", len(entries))
+	}
+}
+
+// newMultipartFileBody returns a multipart body with the values and an avatar file part of the content type (no file
+// part when the content is empty), and its boundary.
+func newMultipartFileBody(t *testing.T, values map[string]string, contentType, content string) (*bytes.Buffer, string) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	for name, value := range values {
+		if err := writer.WriteField(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if content != "" {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", `form-data; name="avatar"; filename="avatar.png"`)
+		header.Set("Content-Type", contentType)
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = part.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return body, writer.Boundary()
+}
+
+func TestValidateMultipartForm(t *testing.T) {
+	type createObject struct {
+		Name   *string               `validations:"type=string;required=true"`
+		Avatar *multipart.FileHeader `validations:"type=file;required=true;maxSize=10;mimeTypes=image/png,image/jpeg"`
+	}
+	tests := []struct {
+		name        string
+		values      map[string]string
+		contentType string
+		content     string
+		wantErrors  []error
+	}{
+		{
+			name:        "test_multipart_form",
+			values:      map[string]string{"name": "Daniel"},
+			contentType: "image/png",
+			content:     "0123456789",
+			wantErrors:  nil,
+		},
+		{
+			name:        "test_multipart_form_required",
+			values:      map[string]string{"name": "Daniel"},
+			contentType: "image/png",
+			content:     "",
+			wantErrors:  []error{ValidationError{Field: "avatar", Message: DefaultMessages["RequiredField"], Code: "required"}},
+		},
+		{
+			name:        "test_multipart_form_max_size",
+			values:      map[string]string{"name": "Daniel"},
+			contentType: "image/png",
+			content:     strings.Repeat("0", 11),
+			wantErrors:  []error{ValidationError{Field: "avatar", Message: defaultMessage("InvalidFileSize", 10), Code: "max_bytes"}},
+		},
+		{
+			name:        "test_multipart_form_mime_types",
+			values:      map[string]string{"name": "Daniel"},
+			contentType: "text/html; charset=utf-8",
+			content:     "<b>0</b>",
+			wantErrors: []error{ValidationError{
+				Field:   "avatar",
+				Message: defaultMessage("InvalidMediaType", "text/html", []string{"image/png", "image/jpeg"}),
+				Code:    "media_type",
+			}},
+		},
+		{
+			name:        "test_multipart_form_values",
+			values:      nil,
+			contentType: "image/jpeg",
+			content:     "0123456789",
+			wantErrors:  []error{ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, boundary := newMultipartFileBody(t, tt.values, tt.contentType, tt.content)
+			mf, err := multipart.NewReader(body, boundary).ReadForm(1 << 20)
+			if err != nil {
+				t.Fatal(err)
+			}
+			form := new(createObject)
+			got := ValidateMultipartForm(mf, form)
+			if !reflect.DeepEqual(got, tt.wantErrors) {
+				t.Errorf("ValidateMultipartForm() = %v, want %v", got, tt.wantErrors)
+			}
+			if tt.wantErrors == nil {
+				if form.Avatar == nil {
+					t.Fatal("ValidateMultipartForm() did not set the file header")
+				}
+				file, err := form.Avatar.Open()
+				if err != nil {
+					t.Fatal(err)
+				}
+				content, _ := io.ReadAll(file)
+				if form.Avatar.Filename != "avatar.png" || string(content) != tt.content {
+					t.Errorf("ValidateMultipartForm() = %v %v, want %v", form.Avatar.Filename, string(content), tt.content)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateMultipartForm_ClosedOnErrors(t *testing.T) {
+	type createObject struct {
+		Name   *string   `validations:"type=string;required=true"`
+		Avatar io.Reader `validations:"type=file"`
+	}
+	body, boundary := newMultipartFileBody(t, nil, "image/png", "0123456789")
+	mf, err := multipart.NewReader(body, boundary).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	form := new(createObject)
+	want := []error{ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"}}
+	if got := ValidateMultipartForm(mf, form); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateMultipartForm() = %v, want %v", got, want)
+	}
+	if form.Avatar != nil {
+		t.Errorf("ValidateMultipartForm() = %v, want the opened file closed and unset", form.Avatar)
+	}
+}
+
+func TestValidateMultipart_MediaTypes(t *testing.T) {
+	type createObject struct {
+		Avatar io.Reader `validations:"type=file;mimeTypes=image/png"`
+	}
+	tests := []struct {
+		name        string
+		contentType string
+		want        []error
+	}{
+		{
+			name:        "test_media_types",
+			contentType: "image/png",
+			want:        nil,
+		},
+		{
+			name:        "test_media_types_error",
+			contentType: "application/pdf",
+			want: []error{ValidationError{
+				Field:   "avatar",
+				Message: defaultMessage("InvalidMediaType", "application/pdf", []string{"image/png"}),
+				Code:    "media_type",
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, boundary := newMultipartFileBody(t, nil, tt.contentType, "0123456789")
+			got := ValidateMultipart(multipart.NewReader(body, boundary), new(createObject))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateMultipart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateRequest_Multipart(t *testing.T) {
+	type createObject struct {
+		Name   *string               `validations:"type=string;required=true"`
+		Avatar *multipart.FileHeader `validations:"type=file;required=true;maxSize=10"`
+	}
+	tests := []struct {
+		name    string
+		content string
+		opts    []Option
+		want    []error
+	}{
+		{
+			name:    "test_request_multipart",
+			content: "0123456789",
+			want:    nil,
+		},
+		{
+			name:    "test_request_multipart_error",
+			content: strings.Repeat("0", 11),
+			want:    []error{ValidationError{Field: "body.avatar", Message: defaultMessage("InvalidFileSize", 10), Code: "max_bytes"}},
+		},
+		{
+			name:    "test_request_multipart_max_bytes",
+			content: strings.Repeat("0", 100),
+			opts:    []Option{WithMaxBytes(50)},
+			want:    []error{PayloadTooLargeError{Limit: 50}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, boundary := newMultipartFileBody(t, map[string]string{"name": "Daniel"}, "image/png", tt.content)
+			r := httptest.NewRequest(http.MethodPost, "/objects", body)
+			r.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
+			got := ValidateRequest(r, nil, new(createObject), tt.opts...)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package jsonValidator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Normalizer rewrites an accepted string value into its canonical form before it is bound (e.g. a phone number into
+// E.164). It returns false when the value cannot be normalized, which rejects it.
+type Normalizer func(value string) (string, bool)
+
+// Normalizers holds the normalizers available to the "normalize=" validation, indexed by name.
+var Normalizers = map[string]Normalizer{
+	"e164":  NormalizeE164,
+	"email": NewEmailNormalizer(EmailPolicy{}),
+	"slug":  NormalizeSlug,
+}
+
+// RegisterNormalizer registers a normalizer under the given name so it can be used as "normalize=name".
+func RegisterNormalizer(name string, normalizer Normalizer) {
+	Normalizers[name] = normalizer
+}
+
+// NormalizeE164 normalizes an international phone number into E.164 ("+" followed by at most 15 digits). The spaces,
+// dashes, dots and parentheses are removed and the "00" international prefix is replaced by "+". Numbers without an
+// international prefix cannot be normalized.
+func NormalizeE164(value string) (string, bool) {
+
+	// 1) Remove the separators.
+	number := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, value)
+
+	// 2) Replace the international prefix.
+	if digits, found := strings.CutPrefix(number, "00"); found {
+		number = "+" + digits
+	}
+
+	// 3) Validate the digits, the country codes never start with 0.
+	digits, found := strings.CutPrefix(number, "+")
+	if !found || len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return "", false
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", false
+		}
+	}
+
+	// 4) Return the normalized number.
+	return number, true
+}
+
+// EmailPolicy configures the normalization of the local part of the email addresses, for the providers that ignore
+// the dots or the "+tag" suffixes (e.g. "john.doe+news@gmail.com" is delivered to "johndoe@gmail.com").
+type EmailPolicy struct {
+	RemoveDots bool
+	RemoveTags bool
+
+	// Domains restricts the policy to the given (lowercase) domains, it applies to every domain when empty.
+	Domains []string
+}
+
+// NewEmailNormalizer returns a normalizer of the email addresses, to be registered with RegisterNormalizer. The
+// addresses must be plain addresses ("john@example.com", without a display name), their domain is lowercased and their
+// local part is normalized according to the policy. The "email" normalizer applies an empty policy.
+func NewEmailNormalizer(policy EmailPolicy) Normalizer {
+	return func(value string) (string, bool) {
+
+		// 1) Validate the address.
+		if !isEmail(value) {
+			return "", false
+		}
+		at := strings.LastIndex(value, "@")
+		local, domain := value[:at], strings.ToLower(value[at+1:])
+
+		// 2) Apply the policy to the local part.
+		applies := len(policy.Domains) == 0
+		for _, policyDomain := range policy.Domains {
+			applies = applies || policyDomain == domain
+		}
+		if applies && policy.RemoveTags {
+			local, _, _ = strings.Cut(local, "+")
+		}
+		if applies && policy.RemoveDots {
+			local = strings.ReplaceAll(local, ".", "")
+		}
+		if local == "" {
+			return "", false
+		}
+
+		// 3) Return the normalized address.
+		return local + "@" + domain, true
+	}
+}
+
+// normalize normalizes the value with the normalizer of the validations. Values without a normalizer are returned
+// unchanged, and a normalizer that is not registered is a ConfigError.
+func (s *state) normalize(validations *Validations, value string, fieldName string) (string, []error) {
+	if validations.Normalize == "" {
+		return value, nil
+	}
+	normalizer, ok := Normalizers[validations.Normalize]
+	if !ok {
+		return value, []error{ConfigError{Message: fmt.Sprintf("no normalizer is registered as %s", validations.Normalize)}}
+	}
+	normalized, ok := normalizer(value)
+	if !ok {
+		s.trigger(fieldName, "normalize")
+		return value, []error{ValidationError{
+			Field:   fieldName,
+			Message: s.options.format("InvalidNormalization", fieldName, validations.Normalize, value),
+			Code:    "normalize",
+		}}
+	}
+	return normalized, nil
+}
+
+func normalizeList[T string | int | int64 | uint64 | float64](s *state, validations *Validations, parsedValues []T, parent string) ([]T, []error) {
+
+	// 1) Initialize an errors list.
+	var errors []error
+
+	// 2) Normalize the string elements, a normalizer that is not registered is reported once.
+	for i, element := range parsedValues {
+		if value, ok := any(element).(string); ok {
+			normalized, errs := s.normalize(validations, value, parent+"["+strconv.Itoa(i)+"]")
+			if _, ok := Normalizers[validations.Normalize]; !ok && errs != nil {
+				return parsedValues, errs
+			}
+			errors = append(errors, errs...)
+			parsedValues[i] = any(normalized).(T)
+		}
+	}
+
+	// 3) Return the normalized values, without the duplicates the normalization may have produced.
+	return removeDuplicate[T](parsedValues), errors
+}
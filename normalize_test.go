@@ -0,0 +1,110 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeE164(t *testing.T) {
+	tests := []struct {
+		input  string
+		want   string
+		wantOk bool
+	}{
+		{"+1 (415) 555-2671", "+14155552671", true},
+		{"0044 20 7183 8750", "+442071838750", true},
+		{"+34.612.345.678", "+34612345678", true},
+		{"415 555 2671", "", false},
+		{"+1 415 CALL NOW", "", false},
+		{"+0123456789", "", false},
+		{"+1234567", "", false},
+		{"+1234567890123456", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := NormalizeE164(tt.input)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("NormalizeE164() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestNewEmailNormalizer(t *testing.T) {
+	gmail := NewEmailNormalizer(EmailPolicy{RemoveDots: true, RemoveTags: true, Domains: []string{"gmail.com"}})
+	tests := []struct {
+		name       string
+		normalizer Normalizer
+		input      string
+		want       string
+		wantOk     bool
+	}{
+		{"test_lowercase_domain", Normalizers["email"], "John.Doe+news@Example.COM", "John.Doe+news@example.com", true},
+		{"test_display_name", Normalizers["email"], "John <john@example.com>", "", false},
+		{"test_invalid", Normalizers["email"], "john.example.com", "", false},
+		{"test_policy", gmail, "John.Doe+news@GMail.com", "JohnDoe@gmail.com", true},
+		{"test_policy_other_domain", gmail, "john.doe+news@example.com", "john.doe+news@example.com", true},
+		{"test_policy_empty_local", gmail, "+news@gmail.com", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.normalizer(tt.input)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("normalizer() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestValidate_Normalize(t *testing.T) {
+	RegisterNormalizer("upper", func(value string) (string, bool) {
+		return strings.ToUpper(value), true
+	})
+	defer delete(Normalizers, "upper")
+	type createObject struct {
+		Phone  *string  `validations:"type=string;normalize=e164"`
+		Phones []string `validations:"type=[]string;normalize=e164"`
+		Code   *string  `validations:"type=string;normalize=upper"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+		wantForm *createObject
+	}{
+		{
+			name:     "test_normalize",
+			jsonData: []byte(`{"phone": "+1 (415) 555-2671", "phones": ["+44 20 7183 8750", "0044 20 7183 8750"], "code": "abc"}`),
+			want:     nil,
+			wantForm: &createObject{
+				Phone:  toStringPointer("+14155552671"),
+				Phones: []string{"+442071838750"},
+				Code:   toStringPointer("ABC"),
+			},
+		},
+		{
+			name:     "test_normalize_invalid",
+			jsonData: []byte(`{"phone": "415 555 2671", "phones": ["+44 20 7183 8750", "n/a"]}`),
+			want: []error{
+				ValidationError{Field: "phone", Message: defaultMessage("InvalidNormalization", "e164", "415 555 2671"), Code: "normalize"},
+				ValidationError{Field: "phones[1]", Message: defaultMessage("InvalidNormalization", "e164", "n/a"), Code: "normalize"},
+			},
+			wantForm: &createObject{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := Validate(tt.jsonData, form)
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(form, tt.wantForm) {
+				t.Errorf("Validate() form = %+v, want %+v", form, tt.wantForm)
+			}
+		})
+	}
+}
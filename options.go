@@ -0,0 +1,355 @@
+package jsonValidator
+
+import (
+	"io"
+	"strings"
+)
+
+// Option configures a validation.
+type Option func(*options)
+
+type options struct {
+	integrityCheck     func(raw []byte) error
+	coercionReport     func(Coercion)
+	offsets            *Offsets
+	maxBytes           int64
+	choicesLimit       int
+	maxDepth           int
+	omitChoices        bool
+	pathPrefix         string
+	ruleCoverage       *RuleCoverage
+	rules              map[string][]string
+	overrides          map[string]RuleOverride
+	flags              map[string]bool
+	strictTypes        bool
+	allowNonFinite     bool
+	jcs                bool
+	auditSink          AuditSink
+	allowUnknownFields bool
+	partial            bool
+	zeroIfAbsent       bool
+	messages           map[string]string
+	translator         Translator
+	locales            []string
+	tags               tagSyntax
+
+	// fromValues is set when the json data was built from string values (headers, multipart...), which have no
+	// meaningful positions.
+	fromValues bool
+}
+
+func newOptions(opts []Option) *options {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithIntegrityCheck verifies the raw body (e.g. an HMAC signature or a hash header) before the validation. The body is
+// checked as it was received, before an empty body is validated as an empty object or a url-encoded body converted.
+// If the check fails the validation stops and an IntegrityError is returned.
+func WithIntegrityCheck(check func(raw []byte) error) Option {
+	return func(o *options) {
+		o.integrityCheck = check
+	}
+}
+
+// WithCoercionReport calls report for every lenient coercion performed during the validation
+// (e.g. the string "123" received for a type=int field).
+func WithCoercionReport(report func(Coercion)) Option {
+	return func(o *options) {
+		o.coercionReport = report
+	}
+}
+
+// Offsets maps the field paths of a validated payload to their byte offsets in the json data.
+type Offsets struct {
+	document *document
+	prefix   string
+}
+
+// OffsetOf returns the byte span [start, end) of the value of a field path (as reported in the errors,
+// e.g. "personList[0].name"). It returns -1, -1 if the path is not in the payload.
+func (o *Offsets) OffsetOf(path string) (start, end int) {
+	if o.document == nil {
+		return -1, -1
+	}
+	if o.prefix != "" {
+		rest, found := strings.CutPrefix(path, o.prefix+".")
+		switch {
+		case path == o.prefix:
+			path = ""
+		case !found:
+			return -1, -1
+		default:
+			path = rest
+		}
+	}
+	i := o.document.lookup(path)
+	if i < 0 {
+		return -1, -1
+	}
+	return o.document.nodes[i].start, o.document.nodes[i].end
+}
+
+// WithOffsets fills offsets with the byte offsets of the validated payload, so the span of any field that
+// produced an error can be highlighted.
+func WithOffsets(offsets *Offsets) Option {
+	return func(o *options) {
+		o.offsets = offsets
+	}
+}
+
+// WithMaxBytes limits the size of the body. Bigger bodies are rejected with a PayloadTooLargeError, which
+// can be mapped to an HTTP 413 instead of a 422. Streamed bodies stop being read as soon as the limit is crossed.
+func WithMaxBytes(maxBytes int64) Option {
+	return func(o *options) {
+		o.maxBytes = maxBytes
+	}
+}
+
+// maxBytesReader reads from r until more than remaining bytes were read, like http.MaxBytesReader.
+type maxBytesReader struct {
+	r         io.Reader
+	remaining int64
+	limit     int64
+}
+
+func (m *maxBytesReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > m.remaining+1 {
+		p = p[:m.remaining+1]
+	}
+	n, err := m.r.Read(p)
+	if int64(n) <= m.remaining {
+		m.remaining -= int64(n)
+		return n, err
+	}
+	n = int(m.remaining)
+	m.remaining = 0
+	return n, PayloadTooLargeError{Limit: m.limit}
+}
+
+// WithChoicesLimit displays at most limit choices in the InvalidChoice messages, followed by the count of the
+// remaining ones ("[1 2 3] and 240 more").
+func WithChoicesLimit(limit int) Option {
+	return func(o *options) {
+		o.choicesLimit = limit
+	}
+}
+
+// WithMaxDepth rejects the objects nested more than depth levels below the root of the json data with the
+// InvalidDepth message, instead of DefaultMaxDepth levels.
+func WithMaxDepth(depth int) Option {
+	return func(o *options) {
+		o.maxDepth = depth
+	}
+}
+
+// depth returns the maximum nesting of the objects, DefaultMaxDepth when it is not set.
+func (o *options) depth() int {
+	if o.maxDepth > 0 {
+		return o.maxDepth
+	}
+	return DefaultMaxDepth
+}
+
+// WithoutChoicesList omits the valid choices from the InvalidChoice messages.
+func WithoutChoicesList() Option {
+	return func(o *options) {
+		o.omitChoices = true
+	}
+}
+
+// WithPathPrefix prefixes the field of every error (e.g. "body.person.name"), so the errors of the body can be
+// merged with the errors of other validated sections (headers, query...) in a single list.
+func WithPathPrefix(prefix string) Option {
+	return func(o *options) {
+		o.pathPrefix = prefix
+	}
+}
+
+// RuleOverride overrides the declared rules of a field for a single validation. The nil rules keep the declared ones.
+type RuleOverride struct {
+	Required *bool
+	Min      *float64
+	Max      *float64
+	Choices  []any
+}
+
+// WithOverrides overrides the rules of the given field paths (e.g. "tags" or "personList.name" for the elements of
+// a list), so the limits can differ per tenant while the tags hold the defaults.
+func WithOverrides(overrides map[string]RuleOverride) Option {
+	return func(o *options) {
+		o.overrides = overrides
+	}
+}
+
+// WithStrictTypes rejects the values whose JSON type is not the declared type of their field (e.g. the string "123"
+// for a type=int field) instead of coercing them, like "strict=true" does for a single field.
+func WithStrictTypes() Option {
+	return func(o *options) {
+		o.strictTypes = true
+	}
+}
+
+// WithNonFiniteFloats binds the NaN and infinite floats coerced from strings ("NaN", "Inf", "-Infinity"...) instead of
+// rejecting them with the NonFiniteNumber message.
+func WithNonFiniteFloats() Option {
+	return func(o *options) {
+		o.allowNonFinite = true
+	}
+}
+
+// WithCanonicalJSON serializes the output of Canonicalize and Marshal with the JSON Canonicalization Scheme
+// (RFC 8785), so the hashes and signatures computed over it do not depend on the encoder: the keys of every object
+// (including the GeoPoint and Money values) are sorted, the numbers are written like ECMAScript writes the doubles,
+// and the strings do not escape "<", ">" and "&". The integers above 2^53 lose their precision, as JCS numbers are
+// IEEE 754 doubles.
+func WithCanonicalJSON() Option {
+	return func(o *options) {
+		o.jcs = true
+	}
+}
+
+// WithAllowUnknownFields ignores the json fields that are not declared in the form instead of rejecting them with the
+// InvalidField message, e.g. for the payloads of third-party webhooks that add fields over time.
+func WithAllowUnknownFields() Option {
+	return func(o *options) {
+		o.allowUnknownFields = true
+	}
+}
+
+// WithPartial skips the required rules, including the conditional ones, so only the received fields are validated,
+// e.g. for the bodies of the PATCH endpoints.
+func WithPartial() Option {
+	return func(o *options) {
+		o.partial = true
+	}
+}
+
+// WithZeroIfAbsent binds the absent optional pointer fields (e.g. *string or *int) to a pointer to the zero value
+// instead of leaving them nil, like "zeroIfAbsent=true" does for a field. The fields with a default get their default,
+// and the partial validations leave the absent fields nil.
+func WithZeroIfAbsent() Option {
+	return func(o *options) {
+		o.zeroIfAbsent = true
+	}
+}
+
+// WithFlags enables the named flags, so the rules guarded by them (declared after "flag=name" in the tags) apply.
+func WithFlags(flags ...string) Option {
+	return func(o *options) {
+		o.flags = make(map[string]bool, len(flags))
+		for _, flag := range flags {
+			o.flags[flag] = true
+		}
+	}
+}
+
+// tagSyntax is the syntax of the validations tags. The empty settings are read from the package defaults.
+type tagSyntax struct {
+	name                 string
+	separator            string
+	choicesSeparator     string
+	choiceLabelSeparator string
+}
+
+// syntax returns the syntax of the tags, with the unset settings read from the package defaults.
+func (o *options) syntax() tagSyntax {
+	syntax := o.tags
+	if syntax.name == "" {
+		syntax.name = DefaultTagName
+	}
+	if syntax.separator == "" {
+		syntax.separator = DefaultSeparator
+	}
+	if syntax.choicesSeparator == "" {
+		syntax.choicesSeparator = DefaultChoicesSeparator
+	}
+	if syntax.choiceLabelSeparator == "" {
+		syntax.choiceLabelSeparator = DefaultChoiceLabelSeparator
+	}
+	return syntax
+}
+
+// defaultSyntax returns the syntax of the tags set by the package defaults.
+func defaultSyntax() tagSyntax {
+	return new(options).syntax()
+}
+
+// message returns the message of the given key, from the WithMessages messages, the translator in the first locale
+// that has it (trying the base language of a regional locale, "es" for "es-MX") or the package defaults.
+func (o *options) message(key string) string {
+	if message, ok := o.messages[key]; ok {
+		return message
+	}
+	translator := o.translator
+	if translator == nil {
+		translator = DefaultCatalogs
+	}
+	for _, locale := range o.locales {
+		if message, ok := translator.Translate(locale, key); ok {
+			return message
+		}
+		if base, _, regional := strings.Cut(locale, "-"); regional {
+			if message, ok := translator.Translate(base, key); ok {
+				return message
+			}
+		}
+	}
+	return defaultMessageOf(key)
+}
+
+// format returns the message of the given key with its placeholders replaced by the field and the values.
+func (o *options) format(key, field string, values ...any) string {
+	return formatMessage(o.message(key), placeholderNames(key), field, values...)
+}
+
+// WithTagName reads the validations from the tags with the given name instead of DefaultTagName.
+func WithTagName(name string) Option {
+	return func(o *options) {
+		o.tags.name = name
+	}
+}
+
+// WithSeparators separates the rules of the tags, the choices and their labels with the given separators instead of
+// DefaultSeparator, DefaultChoicesSeparator and DefaultChoiceLabelSeparator. The empty separators keep the defaults.
+func WithSeparators(separator, choicesSeparator, choiceLabelSeparator string) Option {
+	return func(o *options) {
+		o.tags.separator = separator
+		o.tags.choicesSeparator = choicesSeparator
+		o.tags.choiceLabelSeparator = choiceLabelSeparator
+	}
+}
+
+// WithLocale returns the messages in the first of the given locales that has them, falling back to DefaultMessages
+// (English). The messages given with WithMessages take precedence.
+func WithLocale(locales ...string) Option {
+	return func(o *options) {
+		o.locales = locales
+	}
+}
+
+// WithAcceptLanguage returns the messages in the locales of an Accept-Language header ("es-MX,es;q=0.9,en;q=0.8"),
+// like WithLocale with the locales by decreasing quality.
+func WithAcceptLanguage(header string) Option {
+	return func(o *options) {
+		o.locales = acceptedLocales(header)
+	}
+}
+
+// WithTranslator reads the messages of the locales from the translator instead of DefaultCatalogs.
+func WithTranslator(translator Translator) Option {
+	return func(o *options) {
+		o.translator = translator
+	}
+}
+
+// WithMessages replaces the messages of DefaultMessages with the given ones, keyed like DefaultMessages. The keys that
+// are not given keep the default messages.
+func WithMessages(messages map[string]string) Option {
+	return func(o *options) {
+		o.messages = messages
+	}
+}
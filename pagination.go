@@ -0,0 +1,69 @@
+package jsonValidator
+
+import (
+	"net/url"
+)
+
+// DefaultPageSize is the page size of the paginations without a pageSize parameter.
+var DefaultPageSize = 20
+
+// MaxPageSize is the biggest page size accepted by ValidatePagination.
+var MaxPageSize = 100
+
+// Pagination is the form of the page and pageSize query parameters of the list endpoints.
+type Pagination struct {
+	Page     *int `validations:"type=int;min=1"`
+	PageSize *int `validations:"type=int;min=1;max=100"`
+}
+
+// Offset returns the number of elements before the page.
+func (p *Pagination) Offset() int {
+	return (*p.Page - 1) * *p.PageSize
+}
+
+// Limit returns the number of elements of the page.
+func (p *Pagination) Limit() int {
+	return *p.PageSize
+}
+
+// ValidateQuery validates the query string values against a form received and update the form with the parsed data.
+// The values are matched with the form fields like json keys and the values that are not declared in the form are
+// ignored.
+func ValidateQuery(query url.Values, form any, opts ...Option) []error {
+
+	// 1) Get form value.
+	formValue, err := formValueOf(form)
+	if err != nil {
+		return []error{err}
+	}
+
+	// 2) Validate the declared values.
+	o := newOptions(opts)
+	return validateValues(query, formValue, getValidations(formValue, o.syntax()), o)
+}
+
+// ValidatePagination validates the page and pageSize query parameters, capping the page size at MaxPageSize. The
+// absent parameters are set to the first page and DefaultPageSize.
+func ValidatePagination(query url.Values, opts ...Option) (*Pagination, []error) {
+
+	// 1) Validate the parameters with the page size cap.
+	pagination := new(Pagination)
+	maxPageSize := float64(MaxPageSize)
+	opts = append([]Option{WithOverrides(map[string]RuleOverride{"pageSize": {Max: &maxPageSize}})}, opts...)
+	if errors := ValidateQuery(query, pagination, opts...); errors != nil {
+		return nil, errors
+	}
+
+	// 2) Set the defaults.
+	if pagination.Page == nil {
+		page := 1
+		pagination.Page = &page
+	}
+	if pagination.PageSize == nil {
+		pageSize := DefaultPageSize
+		pagination.PageSize = &pageSize
+	}
+
+	// 3) Return the pagination.
+	return pagination, nil
+}
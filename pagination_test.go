@@ -0,0 +1,59 @@
+package jsonValidator
+
+import (
+	"net/url"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidatePagination(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		want       []error
+		wantOffset int
+		wantLimit  int
+	}{
+		{
+			name:       "test_defaults",
+			query:      "sort=name",
+			wantOffset: 0,
+			wantLimit:  DefaultPageSize,
+		},
+		{
+			name:       "test_pagination",
+			query:      "page=3&pageSize=50",
+			wantOffset: 100,
+			wantLimit:  50,
+		},
+		{
+			name:  "test_invalid",
+			query: "page=0&pageSize=500",
+			want: []error{
+				ValidationError{Field: "page", Message: defaultMessage("InvalidMinNumber", 1), Code: "min"},
+				ValidationError{Field: "pageSize", Message: defaultMessage("InvalidMaxNumber", MaxPageSize), Code: "max"},
+			},
+		},
+		{
+			name:  "test_invalid_format",
+			query: "page=first",
+			want: []error{
+				ValidationError{Field: "page", Message: defaultMessage("InvalidFormat", "first"), Code: "invalid_type"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, _ := url.ParseQuery(tt.query)
+			pagination, got := ValidatePagination(query)
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidatePagination() = %v, want %v", got, tt.want)
+			}
+			if got == nil && (pagination.Offset() != tt.wantOffset || pagination.Limit() != tt.wantLimit) {
+				t.Errorf("ValidatePagination() offset, limit = %v, %v, want %v, %v", pagination.Offset(), pagination.Limit(), tt.wantOffset, tt.wantLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package jsonValidator
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ProblemDetailsContentType is the media type of the ProblemDetails documents.
+const ProblemDetailsContentType = "application/problem+json"
+
+// ProblemDetails is an RFC 9457 (problem+json) document with the validation errors in the "errors" extension.
+type ProblemDetails struct {
+	Type     string         `json:"type"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Errors   []ProblemError `json:"errors"`
+}
+
+// ProblemError is an element of the "errors" extension, locating the invalid member with a JSON pointer, or the invalid
+// query or path parameter or header of a request by its name.
+type ProblemError struct {
+	Detail    string `json:"detail"`
+	Code      string `json:"code,omitempty"`
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+	Header    string `json:"header,omitempty"`
+}
+
+// NewProblemDetails builds the problem details of the validation errors. The status is 413 when the payload is too
+// large, 400 when its integrity check failed, 500 without the details of the errors when they are not of the
+// ClassClient class, and 422 otherwise.
+func NewProblemDetails(errs ValidationErrors) ProblemDetails {
+	return newProblemDetails(errs, func(problemError *ProblemError, field string) {
+		problemError.Pointer = JsonPointer(field)
+	})
+}
+
+// NewRequestProblemDetails builds the problem details of the errors of ValidateRequest, validated with the options.
+// The path prefix of the options and the section are stripped from the fields of the errors: the errors of the body
+// are located with a JSON pointer into the body ("body.person.name" is "#/person/name"), and the ones of the query,
+// path and header sections by the name of their parameter or header. The statuses are the ones of NewProblemDetails.
+func NewRequestProblemDetails(errs ValidationErrors, opts ...Option) ProblemDetails {
+	pathPrefix := newOptions(opts).pathPrefix
+	return newProblemDetails(errs, func(problemError *ProblemError, field string) {
+		if pathPrefix != "" {
+			field = strings.TrimPrefix(field, pathPrefix+".")
+		}
+		switch in, name, _ := strings.Cut(field, "."); in {
+		case "body":
+			problemError.Pointer = JsonPointer(name)
+		case "query", "path":
+			problemError.Parameter = name
+		case "header":
+			problemError.Header = name
+		default:
+			problemError.Pointer = JsonPointer(field)
+		}
+	})
+}
+
+func newProblemDetails(errs ValidationErrors, locate func(problemError *ProblemError, field string)) ProblemDetails {
+
+	// 1) Answer the errors that the client cannot fix with a 500.
+	if errs.Class() != ClassClient {
+		return ProblemDetails{
+			Type:   "about:blank",
+			Title:  http.StatusText(http.StatusInternalServerError),
+			Status: http.StatusInternalServerError,
+			Detail: "The request could not be validated.",
+			Errors: []ProblemError{},
+		}
+	}
+
+	// 2) Initialize the problem details.
+	problem := ProblemDetails{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusUnprocessableEntity),
+		Status: http.StatusUnprocessableEntity,
+		Detail: "The request has validation errors.",
+		Errors: make([]ProblemError, 0, len(errs)),
+	}
+
+	// 3) Convert each error.
+	for _, err := range errs {
+		validationError, isField, status := classifyProblemError(err)
+		if isField {
+			problemError := ProblemError{Detail: validationError.Message, Code: validationError.Code}
+			locate(&problemError, validationError.Field)
+			problem.Errors = append(problem.Errors, problemError)
+			continue
+		}
+		if status != 0 {
+			problem.Title, problem.Status = http.StatusText(status), status
+		}
+		problem.Errors = append(problem.Errors, ProblemError{Detail: err.Error()})
+	}
+
+	// 4) Return the problem details.
+	return problem
+}
+
+// InvalidParamsProblem is an RFC 7807 (problem+json) document with the validation errors in the "invalid-params"
+// extension.
+type InvalidParamsProblem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params"`
+}
+
+// InvalidParam is an element of the "invalid-params" extension, naming the invalid field by its path.
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+	Code   string `json:"code,omitempty"`
+}
+
+// NewInvalidParamsProblem builds the RFC 7807 problem details of the validation errors. The status is 413 when the
+// payload is too large, 400 when its integrity check failed and 422 otherwise, and the errors that are not about a
+// field (an IntegrityError, a PayloadTooLargeError...) are reported in the detail. The errors that are not of the
+// ClassClient class are answered with a 500, without their details.
+func NewInvalidParamsProblem(errs ValidationErrors) InvalidParamsProblem {
+
+	// 1) Answer the errors that the client cannot fix with a 500.
+	if errs.Class() != ClassClient {
+		return InvalidParamsProblem{
+			Type:          "about:blank",
+			Title:         http.StatusText(http.StatusInternalServerError),
+			Status:        http.StatusInternalServerError,
+			Detail:        "The request could not be validated.",
+			InvalidParams: []InvalidParam{},
+		}
+	}
+
+	// 2) Initialize the problem details.
+	problem := InvalidParamsProblem{
+		Type:          "about:blank",
+		Title:         http.StatusText(http.StatusUnprocessableEntity),
+		Status:        http.StatusUnprocessableEntity,
+		InvalidParams: make([]InvalidParam, 0, len(errs)),
+	}
+
+	// 3) Convert each error.
+	var details []string
+	for _, err := range errs {
+		validationError, isField, status := classifyProblemError(err)
+		if isField {
+			problem.InvalidParams = append(problem.InvalidParams, InvalidParam{
+				Name:   validationError.Field,
+				Reason: validationError.Message,
+				Code:   validationError.Code,
+			})
+			continue
+		}
+		if status != 0 {
+			problem.Title, problem.Status = http.StatusText(status), status
+		}
+		details = append(details, err.Error())
+	}
+
+	// 4) Set the detail and return the problem details.
+	problem.Detail = "The request has validation errors."
+	if details != nil {
+		problem.Detail = strings.Join(details, "; ")
+	}
+	return problem
+}
+
+// classifyProblemError returns the ValidationError of the errors about a field, and the status of the problem of the
+// others: 413 for a PayloadTooLargeError, 400 for an IntegrityError and 0 (the status is kept) otherwise.
+func classifyProblemError(err error) (ValidationError, bool, int) {
+	var validationError ValidationError
+	var payloadTooLargeError PayloadTooLargeError
+	var integrityError IntegrityError
+	switch {
+	case errors.As(err, &validationError):
+		return validationError, true, 0
+	case errors.As(err, &payloadTooLargeError):
+		return validationError, false, http.StatusRequestEntityTooLarge
+	case errors.As(err, &integrityError):
+		return validationError, false, http.StatusBadRequest
+	}
+	return validationError, false, 0
+}
+
+// JsonPointer converts an error field path ("personList[0].name") into a JSON pointer fragment ("#/personList/0/name").
+// The "json" field, which is the whole payload, is converted into "#".
+func JsonPointer(field string) string {
+	if field == "json" || field == "" {
+		return "#"
+	}
+	var pointer strings.Builder
+	pointer.WriteString("#")
+	for _, segment := range strings.Split(strings.ReplaceAll(field, "[", ".["), ".") {
+		if segment == "" {
+			continue
+		}
+		segment = strings.Trim(segment, "[]")
+		segment = strings.ReplaceAll(segment, "~", "~0")
+		segment = strings.ReplaceAll(segment, "/", "~1")
+		pointer.WriteString("/" + segment)
+	}
+	return pointer.String()
+}
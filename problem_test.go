@@ -0,0 +1,148 @@
+package jsonValidator
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestNewInvalidParamsProblem(t *testing.T) {
+	tests := []struct {
+		name string
+		errs ValidationErrors
+		want string
+	}{
+		{
+			name: "test_invalid_params",
+			errs: ValidationErrors{
+				ValidationError{Field: "personList[0].name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "age", Message: "This field must be bigger than 18.", Code: "min"},
+			},
+			want: "{\"type\":\"about:blank\",\"title\":\"Unprocessable Entity\",\"status\":422,\"detail\":\"The request has validation errors.\",\"invalid-params\":[{\"name\":\"personList[0].name\",\"reason\":\"This field is required.\",\"code\":\"required\"},{\"name\":\"age\",\"reason\":\"This field must be bigger than 18.\",\"code\":\"min\"}]}",
+		},
+		{
+			name: "test_invalid_params_integrity",
+			errs: ValidationErrors{IntegrityError{Err: errors.New("invalid signature")}},
+			want: "{\"type\":\"about:blank\",\"title\":\"Bad Request\",\"status\":400,\"detail\":\"Integrity check failed: invalid signature\",\"invalid-params\":[]}",
+		},
+		{
+			name: "test_invalid_params_payload_too_large",
+			errs: ValidationErrors{PayloadTooLargeError{Limit: 10}},
+			want: "{\"type\":\"about:blank\",\"title\":\"Request Entity Too Large\",\"status\":413,\"detail\":\"Payload must not have more than 10 bytes\",\"invalid-params\":[]}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(NewInvalidParamsProblem(tt.errs))
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("NewInvalidParamsProblem() = %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestJsonPointer(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"json", "#"},
+		{"name", "#/name"},
+		{"person.name", "#/person/name"},
+		{"personList[0].name", "#/personList/0/name"},
+		{"matrix[1][2]", "#/matrix/1/2"},
+		{"header.Content/Type", "#/header/Content~1Type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := JsonPointer(tt.input); got != tt.want {
+				t.Errorf("JsonPointer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewProblemDetails(t *testing.T) {
+	tests := []struct {
+		name string
+		errs ValidationErrors
+		want string
+	}{
+		{
+			name: "test_problem_details",
+			errs: ValidationErrors{
+				ValidationError{Field: "personList[0].name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				IntegrityError{Err: errors.New("invalid signature")},
+			},
+			want: "{\"type\":\"about:blank\",\"title\":\"Bad Request\",\"status\":400,\"detail\":\"The request has validation errors.\",\"errors\":[{\"detail\":\"This field is required.\",\"code\":\"required\",\"pointer\":\"#/personList/0/name\"},{\"detail\":\"Integrity check failed: invalid signature\"}]}",
+		},
+		{
+			name: "test_problem_details_payload_too_large",
+			errs: ValidationErrors{PayloadTooLargeError{Limit: 10}},
+			want: "{\"type\":\"about:blank\",\"title\":\"Request Entity Too Large\",\"status\":413,\"detail\":\"The request has validation errors.\",\"errors\":[{\"detail\":\"Payload must not have more than 10 bytes\"}]}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(NewProblemDetails(tt.errs))
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("NewProblemDetails() = %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRequestProblemDetails(t *testing.T) {
+	tests := []struct {
+		name string
+		errs ValidationErrors
+		opts []Option
+		want []ProblemError
+	}{
+		{
+			name: "test_request_problem_details",
+			errs: ValidationErrors{
+				ValidationError{Field: "body.personList[0].name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "body.json", Message: "invalid", Code: "invalid_json"},
+				ValidationError{Field: "query.page", Message: "page", Code: "min"},
+				ValidationError{Field: "path.id", Message: "id", Code: "required"},
+				ValidationError{Field: "header.xRequestId", Message: "header", Code: "format"},
+			},
+			want: []ProblemError{
+				{Detail: DefaultMessages["RequiredField"], Code: "required", Pointer: "#/personList/0/name"},
+				{Detail: "invalid", Code: "invalid_json", Pointer: "#"},
+				{Detail: "page", Code: "min", Parameter: "page"},
+				{Detail: "id", Code: "required", Parameter: "id"},
+				{Detail: "header", Code: "format", Header: "xRequestId"},
+			},
+		},
+		{
+			name: "test_request_problem_details_path_prefix",
+			errs: ValidationErrors{
+				ValidationError{Field: "order.body.body.name", Message: DefaultMessages["RequiredField"], Code: "required"},
+				ValidationError{Field: "order.query.page", Message: "page", Code: "min"},
+			},
+			opts: []Option{WithPathPrefix("order")},
+			want: []ProblemError{
+				{Detail: DefaultMessages["RequiredField"], Code: "required", Pointer: "#/body/name"},
+				{Detail: "page", Code: "min", Parameter: "page"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewRequestProblemDetails(tt.errs, tt.opts...)
+			if got.Status != http.StatusUnprocessableEntity || !reflect.DeepEqual(got.Errors, tt.want) {
+				t.Errorf("NewRequestProblemDetails() = %v %v, want %v", got.Status, got.Errors, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+type category struct {
+	Name     *string    `validations:"type=string;required=true"`
+	Children []category `validations:"type=[]struct;max=10"`
+	Parent   *category  `validations:"type=struct"`
+}
+
+func TestValidate_Recursive(t *testing.T) {
+	tests := []struct {
+		name     string
+		jsonData []byte
+		opts     []Option
+		want     []error
+	}{
+		{
+			name:     "test_recursive",
+			jsonData: []byte("{\"name\": \"a\", \"children\": [{\"name\": \"b\", \"children\": [{\"name\": \"c\"}]}], \"parent\": {\"name\": \"d\"}}"),
+		},
+		{
+			name:     "test_recursive_inner_errors",
+			jsonData: []byte("{\"name\": \"a\", \"children\": [{\"name\": \"b\", \"children\": [{}]}], \"parent\": {\"parent\": {\"name\": []}}}"),
+			want: []error{
+				ValidationError{Field: "children[0].children[0].name", Message: defaultMessage("RequiredField"), Code: "required"},
+				ValidationError{Field: "parent.name", Message: defaultMessage("RequiredField"), Code: "required"},
+				ValidationError{Field: "parent.parent.name", Message: defaultMessage("InvalidFormat", []any{}), Code: "invalid_type", Line: 1, Column: 92},
+			},
+		},
+		{
+			name:     "test_recursive_max_depth",
+			jsonData: []byte("{\"name\": \"a\", \"children\": [{\"name\": \"b\", \"children\": [{\"name\": \"c\"}]}], \"parent\": {\"name\": \"d\"}}"),
+			opts:     []Option{WithMaxDepth(1)},
+			want: []error{
+				ValidationError{Field: "children[0].children[0]", Message: defaultMessage("InvalidDepth", 1), Code: "max_depth"},
+			},
+		},
+		{
+			name:     "test_recursive_default_max_depth",
+			jsonData: []byte(strings.Repeat("{\"name\": \"a\", \"parent\": ", DefaultMaxDepth+1) + "null" + strings.Repeat("}", DefaultMaxDepth+1)),
+			want: []error{
+				ValidationError{Field: strings.TrimSuffix(strings.Repeat("parent.", DefaultMaxDepth+1), "."), Message: defaultMessage("InvalidDepth", DefaultMaxDepth), Code: "max_depth"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(category), tt.opts...)
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecursiveForms(t *testing.T) {
+
+	// The helpers walking the form types visit the recursive forms once.
+	if cases := GenerateConformanceCases(category{}); cases[0].Name != "valid" || len(cases[0].Errors) != 0 {
+		t.Errorf("GenerateConformanceCases() = %v, want a valid first case", cases[0])
+	}
+	if got, want := NewRuleCoverage().Untriggered(category{}), []string{"children:max", "children:type", "name:required", "name:type", "parent:type"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Untriggered() = %v, want %v", got, want)
+	}
+	if got := CompareSchemas(category{}, category{}); got != nil {
+		t.Errorf("CompareSchemas() = %v, want nil", got)
+	}
+}
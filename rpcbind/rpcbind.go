@@ -0,0 +1,207 @@
+// Package rpcbind validates the JSON requests of the connect-go and twirp services against jsonValidator forms before
+// their handlers run, answering the invalid ones with the INVALID_ARGUMENT error of each protocol. The services are
+// wrapped as http.Handler, so it does not depend on either framework.
+package rpcbind
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"github.com/packntrack/jsonValidator"
+	"io"
+	"mime"
+	"net/http"
+)
+
+// Forms maps the procedures, by the path of their requests (e.g. "/acme.user.v1.UserService/CreateUser" for connect-go
+// or "/twirp/acme.user.v1.UserService/CreateUser" for twirp), to a function returning a new form of their request.
+type Forms map[string]func() any
+
+// Option configures an interceptor.
+type Option func(*interceptor)
+
+type interceptor struct {
+	forms   Forms
+	options []jsonValidator.Option
+}
+
+// WithOptions passes the options to the validation, e.g. jsonValidator.WithMaxBytes.
+func WithOptions(opts ...jsonValidator.Option) Option {
+	return func(i *interceptor) {
+		i.options = append(i.options, opts...)
+	}
+}
+
+// Connect returns a handler validating the JSON requests (application/json) of the registered procedures before
+// calling next, e.g. the handler returned by a connect-go NewServiceHandler. The invalid requests are answered with a
+// connect "invalid_argument" error, its details holding a google.rpc.BadRequest with a violation per field. The
+// requests of the other procedures, and the protobuf-encoded ones, reach next without being validated.
+func Connect(next http.Handler, forms Forms, opts ...Option) http.Handler {
+	i := newInterceptor(forms, opts)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if errs := i.validate(r); errs != nil {
+			writeConnectError(w, errs)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Twirp returns a handler validating the JSON requests (application/json) of the registered procedures before calling
+// next, e.g. a twirp server. The invalid requests are answered with a twirp "invalid_argument" error, its meta holding
+// the message of each field. The requests of the other procedures, and the protobuf-encoded ones, reach next without
+// being validated.
+func Twirp(next http.Handler, forms Forms, opts ...Option) http.Handler {
+	i := newInterceptor(forms, opts)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if errs := i.validate(r); errs != nil {
+			writeTwirpError(w, errs)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func newInterceptor(forms Forms, opts []Option) *interceptor {
+	i := &interceptor{forms: forms}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// validate validates the body of a JSON request of a registered procedure, and puts it back for the handler.
+func (i *interceptor) validate(r *http.Request) jsonValidator.ValidationErrors {
+
+	// 1) Skip the requests of the other procedures and the protobuf-encoded ones.
+	newForm, ok := i.forms[r.URL.Path]
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); !ok || mediaType != "application/json" {
+		return nil
+	}
+
+	// 2) Validate the body, keeping what was read for the handler.
+	body := new(bytes.Buffer)
+	errs := jsonValidator.ValidateReader(io.TeeReader(r.Body, body), newForm(), i.options...)
+	r.Body = io.NopCloser(body)
+	return errs
+}
+
+// code returns the code of the errors, shared by connect and twirp: "invalid_argument", "resource_exhausted" when the
+// payload is too large, and "internal" when the client cannot fix them.
+func code(errs jsonValidator.ValidationErrors) string {
+	var payloadTooLargeError jsonValidator.PayloadTooLargeError
+	switch {
+	case errs.Class() != jsonValidator.ClassClient:
+		return "internal"
+	case errors.As(errs[0], &payloadTooLargeError):
+		return "resource_exhausted"
+	default:
+		return "invalid_argument"
+	}
+}
+
+// statuses are the HTTP statuses of the codes, the same in both protocols.
+var statuses = map[string]int{
+	"invalid_argument":   http.StatusBadRequest,
+	"resource_exhausted": http.StatusTooManyRequests,
+	"internal":           http.StatusInternalServerError,
+}
+
+type connectError struct {
+	Code    string               `json:"code"`
+	Message string               `json:"message,omitempty"`
+	Details []connectErrorDetail `json:"details,omitempty"`
+}
+
+type connectErrorDetail struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	Debug any    `json:"debug,omitempty"`
+}
+
+// fieldViolation is a google.rpc.BadRequest.FieldViolation.
+type fieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+func writeConnectError(w http.ResponseWriter, errs jsonValidator.ValidationErrors) {
+
+	// 1) Build the error, without the details of the errors that the client cannot fix.
+	connectErr := connectError{Code: code(errs), Message: "the request could not be validated"}
+	if connectErr.Code != "internal" {
+		connectErr.Message = errs.Error()
+		if violations := fieldViolations(errs); violations != nil {
+			connectErr.Details = []connectErrorDetail{{
+				Type:  "google.rpc.BadRequest",
+				Value: base64.RawStdEncoding.EncodeToString(badRequest(violations)),
+				Debug: map[string]any{"fieldViolations": violations},
+			}}
+		}
+	}
+
+	// 2) Write the error.
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statuses[connectErr.Code])
+	_ = json.NewEncoder(w).Encode(connectErr)
+}
+
+type twirpError struct {
+	Code string            `json:"code"`
+	Msg  string            `json:"msg"`
+	Meta map[string]string `json:"meta,omitempty"`
+}
+
+func writeTwirpError(w http.ResponseWriter, errs jsonValidator.ValidationErrors) {
+
+	// 1) Build the error, without the details of the errors that the client cannot fix.
+	twirpErr := twirpError{Code: code(errs), Msg: "the request could not be validated"}
+	if twirpErr.Code != "internal" {
+		twirpErr.Msg = errs.Error()
+		for _, violation := range fieldViolations(errs) {
+			if twirpErr.Meta == nil {
+				twirpErr.Meta = make(map[string]string)
+			}
+			twirpErr.Meta[violation.Field] = violation.Description
+		}
+	}
+
+	// 2) Write the error.
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statuses[twirpErr.Code])
+	_ = json.NewEncoder(w).Encode(twirpErr)
+}
+
+// fieldViolations returns a violation per ValidationError, nil without them.
+func fieldViolations(errs jsonValidator.ValidationErrors) []fieldViolation {
+	var violations []fieldViolation
+	for _, err := range errs {
+		var validationError jsonValidator.ValidationError
+		if errors.As(err, &validationError) {
+			violations = append(violations, fieldViolation{Field: validationError.Field, Description: validationError.Message})
+		}
+	}
+	return violations
+}
+
+// badRequest encodes the violations as a google.rpc.BadRequest protobuf message: each violation is a field_violations
+// (1) message with its field (1) and its description (2).
+func badRequest(violations []fieldViolation) []byte {
+	var message []byte
+	for _, violation := range violations {
+		var fieldViolation []byte
+		fieldViolation = appendString(fieldViolation, 1, violation.Field)
+		fieldViolation = appendString(fieldViolation, 2, violation.Description)
+		message = appendString(message, 1, string(fieldViolation))
+	}
+	return message
+}
+
+// appendString appends a length-delimited protobuf field.
+func appendString(message []byte, number uint64, value string) []byte {
+	message = binary.AppendUvarint(message, number<<3|2)
+	message = binary.AppendUvarint(message, uint64(len(value)))
+	return append(message, value...)
+}
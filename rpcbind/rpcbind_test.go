@@ -0,0 +1,128 @@
+package rpcbind
+
+import (
+	"encoding/base64"
+	"github.com/packntrack/jsonValidator"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type createUser struct {
+	Name *string `validations:"type=string;required=true"`
+	Age  *int    `validations:"type=int;min=18"`
+}
+
+// echo answers the body it received, checking that the interceptor put it back.
+var echo = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	_, _ = w.Write(body)
+})
+
+var forms = Forms{
+	"/acme.user.v1.UserService/CreateUser":       func() any { return new(createUser) },
+	"/twirp/acme.user.v1.UserService/CreateUser": func() any { return new(createUser) },
+}
+
+func TestConnect(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      string
+		contentType string
+		body        string
+		opts        []Option
+		wantStatus  int
+		wantBody    string
+	}{
+		{
+			name:        "test_connect",
+			target:      "/acme.user.v1.UserService/CreateUser",
+			contentType: "application/json",
+			body:        `{"name": "Daniel", "age": 30}`,
+			wantStatus:  http.StatusOK,
+			wantBody:    `{"name": "Daniel", "age": 30}`,
+		},
+		{
+			name:        "test_connect_invalid_argument",
+			target:      "/acme.user.v1.UserService/CreateUser",
+			contentType: "application/json",
+			body:        `{"age": 20}`,
+			wantStatus:  http.StatusBadRequest,
+			wantBody: `{"code":"invalid_argument","message":"Field name: This field is required.","details":[{"type":"google.rpc.BadRequest",` +
+				`"value":"` + base64.RawStdEncoding.EncodeToString([]byte("\x0a\x1f\x0a\x04name\x12\x17This field is required.")) + `",` +
+				`"debug":{"fieldViolations":[{"field":"name","description":"This field is required."}]}}]}`,
+		},
+		{
+			name:        "test_connect_resource_exhausted",
+			target:      "/acme.user.v1.UserService/CreateUser",
+			contentType: "application/json",
+			body:        `{"name": "Daniel Silva"}`,
+			opts:        []Option{WithOptions(jsonValidator.WithMaxBytes(10))},
+			wantStatus:  http.StatusTooManyRequests,
+			wantBody:    `{"code":"resource_exhausted","message":"Payload must not have more than 10 bytes"}`,
+		},
+		{
+			name:        "test_connect_protobuf",
+			target:      "/acme.user.v1.UserService/CreateUser",
+			contentType: "application/proto",
+			body:        "{}",
+			wantStatus:  http.StatusOK,
+			wantBody:    "{}",
+		},
+		{
+			name:        "test_connect_unregistered",
+			target:      "/acme.user.v1.UserService/DeleteUser",
+			contentType: "application/json",
+			body:        "{}",
+			wantStatus:  http.StatusOK,
+			wantBody:    "{}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", tt.contentType)
+			w := httptest.NewRecorder()
+			Connect(echo, forms, tt.opts...).ServeHTTP(w, r)
+			if w.Code != tt.wantStatus || strings.TrimSpace(w.Body.String()) != tt.wantBody {
+				t.Errorf("Connect() = %v %v, want %v %v", w.Code, w.Body.String(), tt.wantStatus, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestTwirp(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "test_twirp",
+			body:       `{"name": "Daniel", "age": 30}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"name": "Daniel", "age": 30}`,
+		},
+		{
+			name:       "test_twirp_invalid_argument",
+			body:       `{"name": "Daniel", "age": 17}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody: `{"code":"invalid_argument","msg":"Field age: This field must be bigger than 18.",` +
+				`"meta":{"age":"This field must be bigger than 18."}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/twirp/acme.user.v1.UserService/CreateUser", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			Twirp(echo, forms).ServeHTTP(w, r)
+			if w.Code != tt.wantStatus || strings.TrimSpace(w.Body.String()) != tt.wantBody {
+				t.Errorf("Twirp() = %v %v, want %v %v", w.Code, w.Body.String(), tt.wantStatus, tt.wantBody)
+			}
+		})
+	}
+}
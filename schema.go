@@ -0,0 +1,132 @@
+package jsonValidator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Schema is a compiled form. A schema is never modified once compiled: the per-tenant or per-experiment variations
+// of a base schema are derived with Clone and WithRule, so they can be shared between goroutines.
+type Schema struct {
+	formType reflect.Type
+	rules    map[string][]string
+	opts     []Option
+}
+
+// Compile compiles the form, which must be a struct or a pointer to a struct. The tags of the form are parsed once,
+// with the tag syntax of the options, and cached for the later validations of its type. The options are applied to
+// every validation of the schema, before the options of each call.
+func Compile(form any, opts ...Option) (*Schema, error) {
+	formType := reflect.TypeOf(form)
+	if formType != nil && formType.Kind() == reflect.Pointer {
+		formType = formType.Elem()
+	}
+	if formType == nil || formType.Kind() != reflect.Struct {
+		return nil, errors.New("jsonValidator: Compile expects a struct or a pointer to a struct")
+	}
+	getValidations(reflect.New(formType).Elem(), newOptions(opts).syntax())
+	return &Schema{formType: formType, opts: opts}, nil
+}
+
+// Clone returns a copy of the schema.
+func (s *Schema) Clone() *Schema {
+	rules := make(map[string][]string, len(s.rules))
+	for field, fieldRules := range s.rules {
+		rules[field] = fieldRules
+	}
+	return &Schema{formType: s.formType, rules: rules, opts: s.opts}
+}
+
+// WithRule returns a copy of the schema where the rule, written as in the tags (e.g. "max=20"), overrides the
+// declared one of the field path (e.g. "person.name" or "personList.name" for the elements of a list).
+func (s *Schema) WithRule(field, rule string) *Schema {
+	clone := s.Clone()
+	clone.rules[field] = append(append([]string(nil), clone.rules[field]...), rule)
+	return clone
+}
+
+// Validate validates the json data against the schema and updates the form, a pointer to the compiled struct,
+// with the parsed data. A form of another type is reported as a ConfigError.
+func (s *Schema) Validate(jsonData []byte, form any, opts ...Option) []error {
+	if formValue, err := formValueOf(form); err != nil {
+		return []error{err}
+	} else if formValue.Type() != s.formType {
+		return []error{ConfigError{Message: fmt.Sprintf("the schema of %s cannot validate a form of type %s", s.formType, formValue.Type())}}
+	}
+	o := newOptions(append(append([]Option(nil), s.opts...), opts...))
+	o.rules = s.rules
+	return validate(jsonData, form, o)
+}
+
+// withRules returns the validations of the fields of parent, with the enabled flags, the rules of the schema and the
+// overrides applied.
+func (s *state) withRules(form reflect.Value, validationsMap map[string]*Validations, parent string) map[string]*Validations {
+
+	// 1) Nothing to apply without rules, overrides nor flags.
+	if len(s.options.rules) == 0 && len(s.options.overrides) == 0 && len(s.options.flags) == 0 {
+		return validationsMap
+	}
+
+	// 2) Apply the rules and overrides on a copy of the validations.
+	parent = s.rulePath(parent)
+	result := make(map[string]*Validations, len(validationsMap))
+	for fieldName, validations := range validationsMap {
+		path := getFieldName(parent, fieldName)
+		rules, hasRules := s.options.rules[path]
+		override, hasOverride := s.options.overrides[path]
+		flagged := validations.flagged && len(s.options.flags) > 0
+		if !hasRules && !hasOverride && !flagged {
+			result[fieldName] = validations
+			continue
+		}
+
+		// 2.1) Parse again the tags of the field with the enabled flags, followed by the rules of the schema, and bind them
+		// to the same field of the form.
+		copied := *validations
+		if hasRules || flagged {
+			field := promotedField{index: validations.index, indirect: validations.indirect}
+			if validations.index != nil {
+				field.StructField, field.depth = form.Type().FieldByIndex(validations.index), len(validations.index)-1
+			} else {
+				field.StructField, _ = form.Type().FieldByName(validations.structField)
+			}
+			field.Offset = validations.offset
+			syntax := s.options.syntax()
+			tags, _ := flaggedRules(strings.Split(field.Tag.Get(syntax.name), syntax.separator), s.options.flags)
+			copied = *parseValidationTags(append(tags, rules...), syntax)
+			copied.flagged = validations.flagged
+			copied.bindField(field)
+		}
+
+		// 2.2) Apply the override.
+		if override.Required != nil {
+			copied.Required = *override.Required
+		}
+		if override.Min != nil {
+			copied.Min = *override.Min
+		}
+		if override.Max != nil {
+			copied.Max = *override.Max
+		}
+		if override.Choices != nil {
+			copied.Choices, copied.ChoiceLabels = override.Choices, nil
+		}
+		result[fieldName] = &copied
+	}
+
+	// 3) Return the validations.
+	return result
+}
+
+// rulePath returns the path of the rules of a field: the path of the error without the prefix nor the list indexes.
+func (s *state) rulePath(fieldName string) string {
+	if prefix := s.options.pathPrefix; prefix != "" {
+		if fieldName == prefix {
+			return ""
+		}
+		fieldName = strings.TrimPrefix(fieldName, prefix+".")
+	}
+	return listIndexes.ReplaceAllString(fieldName, "")
+}
@@ -0,0 +1,302 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+)
+
+// Change is a difference between the validations of two versions of a form.
+type Change struct {
+	Field string
+	// Kind is "added", "removed", "tightened", "loosened" or "changed".
+	Kind string
+	// Rule is the changed rule ("type", "required", "min", "max" or "choices"), empty for added or removed fields.
+	Rule string
+	Old  any
+	New  any
+	// Breaking is true when payloads valid for the old form may be invalid for the new one.
+	Breaking bool
+}
+
+// CompareSchemas reports the validations that were added, removed, tightened or loosened between two versions of a
+// form (including the inner structs), so backward-incompatible changes can be flagged before a release.
+func CompareSchemas(oldForm, newForm any) []Change {
+	changes := compareForms(reflect.TypeOf(oldForm), reflect.TypeOf(newForm), "")
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
+
+// structType returns the struct type of a form or of a struct field, dereferencing pointers and slices.
+func structType(formType reflect.Type) reflect.Type {
+	for formType.Kind() == reflect.Pointer || formType.Kind() == reflect.Slice {
+		formType = formType.Elem()
+	}
+	return formType
+}
+
+// containsType reports whether the type is one of the types, e.g. one of the forms enclosing a field of a recursive form.
+func containsType(types []reflect.Type, formType reflect.Type) bool {
+	for _, t := range types {
+		if t == formType {
+			return true
+		}
+	}
+	return false
+}
+
+func compareForms(oldType, newType reflect.Type, parent string, ancestors ...[2]reflect.Type) []Change {
+
+	// 1) Get the validations of both forms, the recursive forms are compared once.
+	oldType, newType = structType(oldType), structType(newType)
+	if oldType.Kind() != reflect.Struct || newType.Kind() != reflect.Struct {
+		return nil
+	}
+	for _, ancestor := range ancestors {
+		if ancestor == [2]reflect.Type{oldType, newType} {
+			return nil
+		}
+	}
+	ancestors = append(ancestors, [2]reflect.Type{oldType, newType})
+	oldMap := getValidations(reflect.New(oldType).Elem(), defaultSyntax())
+	newMap := getValidations(reflect.New(newType).Elem(), defaultSyntax())
+	var changes []Change
+
+	// 2) Compare the old fields with the new ones.
+	for fieldName, oldValidations := range oldMap {
+		field := getFieldName(parent, fieldName)
+		newValidations, ok := newMap[fieldName]
+		switch {
+		case oldValidations.Type == "":
+			continue
+		case !ok || newValidations.Type == "":
+			changes = append(changes, Change{Field: field, Kind: "removed", Breaking: true})
+		case oldValidations.Type != newValidations.Type:
+			changes = append(changes, Change{Field: field, Kind: "changed", Rule: "type", Old: oldValidations.Type, New: newValidations.Type, Breaking: true})
+		default:
+			changes = append(changes, compareRules(field, oldValidations, newValidations)...)
+
+			// 2.1) Compare the inner structs.
+			if oldValidations.Type == "struct" || oldValidations.Type == "[]struct" {
+				oldField, _ := oldType.FieldByName(oldValidations.structField)
+				newField, _ := newType.FieldByName(newValidations.structField)
+				changes = append(changes, compareForms(oldField.Type, newField.Type, field, ancestors...)...)
+			}
+
+			// 2.2) Compare the rules of the map values, and their structs.
+			if oldValidations.Values != nil && newValidations.Values != nil {
+				changes = append(changes, compareRules(field, oldValidations.Values, newValidations.Values)...)
+				if oldValidations.Type == "map[string]struct" {
+					oldField, _ := oldType.FieldByName(oldValidations.structField)
+					newField, _ := newType.FieldByName(newValidations.structField)
+					changes = append(changes, compareForms(structType(oldField.Type).Elem(), structType(newField.Type).Elem(), field, ancestors...)...)
+				}
+			}
+		}
+	}
+
+	// 3) Report the new fields.
+	for fieldName, newValidations := range newMap {
+		if oldValidations, ok := oldMap[fieldName]; newValidations.Type == "" || ok && oldValidations.Type != "" {
+			continue
+		}
+		changes = append(changes, Change{Field: getFieldName(parent, fieldName), Kind: "added", Breaking: newValidations.Required})
+	}
+
+	// 4) Return the changes.
+	return changes
+}
+
+func compareRules(field string, oldValidations, newValidations *Validations) []Change {
+
+	// 1) Initialize the changes list.
+	var changes []Change
+	change := func(tightened bool, rule string, oldValue, newValue any) {
+		kind := "loosened"
+		if tightened {
+			kind = "tightened"
+		}
+		changes = append(changes, Change{Field: field, Kind: kind, Rule: rule, Old: oldValue, New: newValue, Breaking: tightened})
+	}
+
+	// 2) Compare required, the conditional requirements, the custom validations, the checksums, the formats, their
+	// prefixes and media types, the providers of choices, country, the list of the allowed values, the order of the
+	// lists, the intervals, the aggregated members, fields and operators, nullable, strict, the empty lists, the bidi
+	// control characters, the emoji and the markup.
+	if oldValidations.Required != newValidations.Required {
+		change(newValidations.Required, "required", oldValidations.Required, newValidations.Required)
+	}
+
+	if oldValidations.DisallowEmptyList != newValidations.DisallowEmptyList {
+		change(newValidations.DisallowEmptyList, "allowEmptyList", !oldValidations.DisallowEmptyList, !newValidations.DisallowEmptyList)
+	}
+	ruleChanges := []struct {
+		rule     string
+		old, new any
+		set      bool
+	}{
+		{"required_if", oldValidations.RequiredIf, newValidations.RequiredIf, newValidations.RequiredIf != nil},
+		{"required_unless", oldValidations.RequiredUnless, newValidations.RequiredUnless, newValidations.RequiredUnless != nil},
+		{"required_with", oldValidations.RequiredWith, newValidations.RequiredWith, newValidations.RequiredWith != nil},
+		{"custom", oldValidations.Custom, newValidations.Custom, newValidations.Custom != nil},
+		{"checksum", oldValidations.Checksum, newValidations.Checksum, newValidations.Checksum != ""},
+		{"checksumWeights", oldValidations.ChecksumWeights, newValidations.ChecksumWeights, newValidations.Checksum != ""},
+		{"format", oldValidations.Format, newValidations.Format, newValidations.Format != ""},
+		{"prefix", oldValidations.Prefixes, newValidations.Prefixes, newValidations.Prefixes != nil},
+		{"mediaTypes", oldValidations.MediaTypes, newValidations.MediaTypes, newValidations.MediaTypes != nil},
+		{"choicesFunc", oldValidations.ChoicesFunc, newValidations.ChoicesFunc, newValidations.ChoicesFunc != ""},
+		{"country", oldValidations.Country, newValidations.Country, newValidations.Country != ""},
+		{"inField", oldValidations.InField, newValidations.InField, newValidations.InField != ""},
+		{"sorted", oldValidations.Sorted, newValidations.Sorted, newValidations.Sorted != ""},
+		{"sortedBy", oldValidations.SortedBy, newValidations.SortedBy, newValidations.Sorted != ""},
+		{"nonOverlapping", [2]string{oldValidations.IntervalStart, oldValidations.IntervalEnd}, [2]string{newValidations.IntervalStart, newValidations.IntervalEnd}, newValidations.IntervalStart != ""},
+		{"sumField", oldValidations.SumField, newValidations.SumField, newValidations.SumField != ""},
+		{"avgField", oldValidations.AvgField, newValidations.AvgField, newValidations.AvgField != ""},
+		{"fields", oldValidations.Fields, newValidations.Fields, newValidations.Fields != nil},
+		{"operators", oldValidations.Operators, newValidations.Operators, newValidations.Operators != nil},
+	}
+	for _, rule := range ruleChanges {
+		if !reflect.DeepEqual(rule.old, rule.new) {
+			kind := "changed"
+			if !rule.set {
+				kind = "loosened"
+			}
+			changes = append(changes, Change{Field: field, Kind: kind, Rule: rule.rule, Old: rule.old, New: rule.new, Breaking: rule.set})
+		}
+	}
+	if oldValidations.Nullable != newValidations.Nullable {
+		change(!newValidations.Nullable, "nullable", oldValidations.Nullable, newValidations.Nullable)
+	}
+	if oldValidations.Strict != newValidations.Strict {
+		change(newValidations.Strict, "strict", oldValidations.Strict, newValidations.Strict)
+	}
+	if oldValidations.DisallowBidi != newValidations.DisallowBidi {
+		change(newValidations.DisallowBidi, "allowBidi", !oldValidations.DisallowBidi, !newValidations.DisallowBidi)
+	}
+	if oldValidations.NoEmoji != newValidations.NoEmoji {
+		change(newValidations.NoEmoji, "noEmoji", oldValidations.NoEmoji, newValidations.NoEmoji)
+	}
+	if oldValidations.NoMarkup != newValidations.NoMarkup {
+		change(newValidations.NoMarkup, "noMarkup", oldValidations.NoMarkup, newValidations.NoMarkup)
+	}
+
+	// 3) Compare min, max, the exact lengths (any new length rejects the other lengths), the keys of the maps, the digits
+	// of the strings, the bytes of the files and data URIs, the sums and averages of the lists, scale, precision and the
+	// epsilon of the floats, a zero value (a negative scale) means the rule is not set.
+	if oldValidations.Min != newValidations.Min {
+		change(newValidations.Min > oldValidations.Min, "min", oldValidations.Min, newValidations.Min)
+	}
+	if oldValidations.Max != newValidations.Max {
+		change(newValidations.Max != 0 && (oldValidations.Max == 0 || newValidations.Max < oldValidations.Max), "max", oldValidations.Max, newValidations.Max)
+	}
+	if oldValidations.Len != newValidations.Len {
+		change(newValidations.Len != 0, "len", oldValidations.Len, newValidations.Len)
+	}
+	if oldValidations.MinKeys != newValidations.MinKeys {
+		change(newValidations.MinKeys > oldValidations.MinKeys, "minKeys", oldValidations.MinKeys, newValidations.MinKeys)
+	}
+	if oldValidations.MaxKeys != newValidations.MaxKeys {
+		change(newValidations.MaxKeys != 0 && (oldValidations.MaxKeys == 0 || newValidations.MaxKeys < oldValidations.MaxKeys), "maxKeys", oldValidations.MaxKeys, newValidations.MaxKeys)
+	}
+	if oldValidations.MinDigits != newValidations.MinDigits {
+		change(newValidations.MinDigits > oldValidations.MinDigits, "minDigits", oldValidations.MinDigits, newValidations.MinDigits)
+	}
+	if oldValidations.MaxDigits != newValidations.MaxDigits {
+		change(newValidations.MaxDigits != 0 && (oldValidations.MaxDigits == 0 || newValidations.MaxDigits < oldValidations.MaxDigits), "maxDigits", oldValidations.MaxDigits, newValidations.MaxDigits)
+	}
+	if oldValidations.MaxBytes != newValidations.MaxBytes {
+		change(newValidations.MaxBytes != 0 && (oldValidations.MaxBytes == 0 || newValidations.MaxBytes < oldValidations.MaxBytes), "maxBytes", oldValidations.MaxBytes, newValidations.MaxBytes)
+	}
+	if oldValidations.SumMin != newValidations.SumMin {
+		change(newValidations.SumMin > oldValidations.SumMin, "sumMin", oldValidations.SumMin, newValidations.SumMin)
+	}
+	if oldValidations.SumMax != newValidations.SumMax {
+		change(newValidations.SumMax != 0 && (oldValidations.SumMax == 0 || newValidations.SumMax < oldValidations.SumMax), "sumMax", oldValidations.SumMax, newValidations.SumMax)
+	}
+	if oldValidations.AvgMin != newValidations.AvgMin {
+		change(newValidations.AvgMin > oldValidations.AvgMin, "avgMin", oldValidations.AvgMin, newValidations.AvgMin)
+	}
+	if oldValidations.AvgMax != newValidations.AvgMax {
+		change(newValidations.AvgMax != 0 && (oldValidations.AvgMax == 0 || newValidations.AvgMax < oldValidations.AvgMax), "avgMax", oldValidations.AvgMax, newValidations.AvgMax)
+	}
+	if oldValidations.Scale != newValidations.Scale {
+		change(newValidations.Scale >= 0 && (oldValidations.Scale < 0 || newValidations.Scale < oldValidations.Scale), "scale", oldValidations.Scale, newValidations.Scale)
+	}
+	if oldValidations.Precision != newValidations.Precision {
+		change(newValidations.Precision != 0 && (oldValidations.Precision == 0 || newValidations.Precision < oldValidations.Precision), "precision", oldValidations.Precision, newValidations.Precision)
+	}
+	if oldValidations.Epsilon != newValidations.Epsilon {
+		change(newValidations.Epsilon < oldValidations.Epsilon, "epsilon", oldValidations.Epsilon, newValidations.Epsilon)
+	}
+
+	// 4) Compare choices, removing any choice (or adding choices to a free field) is tightening.
+	if !reflect.DeepEqual(oldValidations.Choices, newValidations.Choices) {
+		tightened := newValidations.Choices != nil && oldValidations.Choices == nil
+		for _, choice := range oldValidations.Choices {
+			if newValidations.Choices != nil && !containsAny(newValidations.Choices, choice) {
+				tightened = true
+			}
+		}
+		change(tightened, "choices", oldValidations.Choices, newValidations.Choices)
+	}
+
+	// 4.1) Compare the excluded values, excluding any new value is tightening.
+	if !reflect.DeepEqual(oldValidations.Excluded, newValidations.Excluded) {
+		tightened := false
+		for _, excluded := range newValidations.Excluded {
+			if !containsAny(oldValidations.Excluded, excluded) {
+				tightened = true
+			}
+		}
+		change(tightened, "exclude", oldValidations.Excluded, newValidations.Excluded)
+	}
+
+	// 5) Compare currencies, removing any currency (or restricting the currencies) is tightening.
+	if !reflect.DeepEqual(oldValidations.Currencies, newValidations.Currencies) {
+		tightened := newValidations.Currencies != nil && oldValidations.Currencies == nil
+		for _, currency := range oldValidations.Currencies {
+			if newValidations.Currencies != nil && !containsAny(toAny(newValidations.Currencies), currency) {
+				tightened = true
+			}
+		}
+		change(tightened, "currencies", oldValidations.Currencies, newValidations.Currencies)
+	}
+
+	// 6) Compare patterns, any new pattern may reject payloads that were accepted.
+	oldPattern, newPattern := patternString(oldValidations.Pattern), patternString(newValidations.Pattern)
+	if oldPattern != newPattern {
+		kind := "changed"
+		if newPattern == "" {
+			kind = "loosened"
+		}
+		changes = append(changes, Change{Field: field, Kind: kind, Rule: "pattern", Old: oldPattern, New: newPattern, Breaking: newPattern != ""})
+	}
+
+	// 7) Compare normalizers, any new normalizer may reject payloads that were accepted.
+	if oldValidations.Normalize != newValidations.Normalize {
+		kind := "changed"
+		if newValidations.Normalize == "" {
+			kind = "loosened"
+		}
+		changes = append(changes, Change{Field: field, Kind: kind, Rule: "normalize", Old: oldValidations.Normalize, New: newValidations.Normalize, Breaking: newValidations.Normalize != ""})
+	}
+
+	// 8) Return the changes.
+	return changes
+}
+
+func patternString(pattern *regexp.Regexp) string {
+	if pattern == nil {
+		return ""
+	}
+	return pattern.String()
+}
+
+func containsAny(sliceList []any, value any) bool {
+	for _, element := range sliceList {
+		if element == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompareSchemas(t *testing.T) {
+	type PersonV1 struct {
+		Name *string `validations:"type=string"`
+	}
+	type PersonV2 struct {
+		Name *string `validations:"type=string;required=true"`
+	}
+	type createObjectV1 struct {
+		Name     *string   `validations:"type=string;min=1;max=10"`
+		Code     *int      `validations:"type=int;choices=1,2,3"`
+		Price    *float64  `validations:"type=float"`
+		Status   *string   `validations:"type=string;choices=a,b"`
+		Owners   []string  `validations:"type=[]string;required=true"`
+		Person   *PersonV1 `validations:"type=struct"`
+		Internal *string
+	}
+	type createObjectV2 struct {
+		Name     *string   `validations:"type=string;min=2"`
+		Code     *int      `validations:"type=int;choices=1,2"`
+		Price    *string   `validations:"type=string"`
+		Status   *string   `validations:"type=string;choices=a,b,c"`
+		Owners   []string  `validations:"type=[]string"`
+		Person   *PersonV2 `validations:"type=struct"`
+		Currency *string   `validations:"type=string;required=true"`
+		Comment  *string   `validations:"type=string"`
+	}
+	want := []Change{
+		{Field: "code", Kind: "tightened", Rule: "choices", Old: []any{1, 2, 3}, New: []any{1, 2}, Breaking: true},
+		{Field: "comment", Kind: "added"},
+		{Field: "currency", Kind: "added", Breaking: true},
+		{Field: "name", Kind: "tightened", Rule: "min", Old: 1.0, New: 2.0, Breaking: true},
+		{Field: "name", Kind: "loosened", Rule: "max", Old: 10.0, New: 0.0},
+		{Field: "owners", Kind: "loosened", Rule: "required", Old: true, New: false},
+		{Field: "person.name", Kind: "tightened", Rule: "required", Old: false, New: true, Breaking: true},
+		{Field: "price", Kind: "changed", Rule: "type", Old: "float", New: "string", Breaking: true},
+		{Field: "status", Kind: "loosened", Rule: "choices", Old: []any{"a", "b"}, New: []any{"a", "b", "c"}},
+	}
+	if got := CompareSchemas(createObjectV1{}, new(createObjectV2)); !reflect.DeepEqual(got, want) {
+		t.Errorf("CompareSchemas() = %v, want %v", got, want)
+	}
+	if got := CompareSchemas(createObjectV1{}, createObjectV1{}); got != nil {
+		t.Errorf("CompareSchemas() = %v, want nil", got)
+	}
+}
@@ -0,0 +1,300 @@
+package jsonValidator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSchemaWithRule(t *testing.T) {
+	type Person struct {
+		Name *string `validations:"type=string"`
+	}
+	type createObject struct {
+		Name       *string  `validations:"type=string;max=10"`
+		Code       *int     `validations:"type=int;required=true"`
+		PersonList []Person `validations:"type=[]struct"`
+	}
+	base, err := Compile(createObject{})
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	tenant := base.Clone().WithRule("name", "max=3").WithRule("code", "required=false").WithRule("personList.name", "required=true")
+	jsonData := []byte(`{"name": "abcdef", "personList": [{}]}`)
+	tests := []struct {
+		name   string
+		schema *Schema
+		want   []error
+	}{
+		{
+			name:   "base schema",
+			schema: base,
+			want: []error{
+				ValidationError{Field: "code", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+		{
+			name:   "derived schema",
+			schema: tenant,
+			want: []error{
+				ValidationError{Field: "name", Message: "This field must not have more than 3 characters.", Code: "max"},
+				ValidationError{Field: "personList[0].name", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.schema.Validate(jsonData, new(createObject))
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if _, err := Compile("form"); err == nil {
+		t.Errorf("Compile() error = nil, want an error")
+	}
+}
+
+func TestSchemaValidate_Reuse(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string;required=true"`
+		Code *int    `validations:"type=int;required=true"`
+	}
+	schema, err := Compile(new(createObject))
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if _, ok := validationsCache.Load(validationsKey{formType: reflect.TypeOf(createObject{}), syntax: defaultSyntax()}); !ok {
+		t.Errorf("Compile() did not cache the validations of the form")
+	}
+
+	// The validations updated by a validation (e.g. the received required fields) must not leak into the next ones.
+	want := []error{ValidationError{Field: "code", Message: DefaultMessages["RequiredField"], Code: "required"}}
+	for i := 0; i < 2; i++ {
+		got := schema.Validate([]byte(`{"name": "abc"}`), new(createObject))
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Validate() #%v = %v, want %v", i, got, want)
+		}
+	}
+	got := schema.Validate([]byte(`{"code": 1}`), new(createObject))
+	want = []error{ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate() = %v, want %v", got, want)
+	}
+}
+
+func TestCompile_TagSyntax(t *testing.T) {
+	type createObject struct {
+		Name *string `rules:"type=string|required=true"`
+	}
+	syntax := tagSyntax{name: "rules", separator: "|", choicesSeparator: "+", choiceLabelSeparator: "/"}
+	schema, err := Compile(new(createObject), WithTagName("rules"), WithSeparators("|", "+", "/"))
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if _, ok := validationsCache.Load(validationsKey{formType: reflect.TypeOf(createObject{}), syntax: syntax}); !ok {
+		t.Errorf("Compile() did not cache the validations of the form with its tag syntax")
+	}
+	want := []error{ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"}}
+	if got := schema.Validate([]byte(`{}`), new(createObject)); !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate() = %v, want %v", got, want)
+	}
+}
+
+func TestSchemaValidate_FormType(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string"`
+	}
+	type otherObject struct {
+		Name *string `validations:"type=string"`
+	}
+	schema, err := Compile(createObject{})
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	got := schema.Validate([]byte(`{"name": "abc"}`), new(otherObject))
+	want := []error{ConfigError{Message: fmt.Sprintf("the schema of %s cannot validate a form of type %s", reflect.TypeOf(createObject{}), reflect.TypeOf(otherObject{}))}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate() = %v, want %v", got, want)
+	}
+}
+
+func TestSchemaWithRule_ValueFields(t *testing.T) {
+	type createObject struct {
+		Name   string  `validations:"type=string;flag=limits;max=5"`
+		Code   int     `validations:"type=int;flag=limits;max=100"`
+		Price  float64 `validations:"type=float;flag=limits;max=100"`
+		Active bool    `validations:"type=bool;flag=limits;required=true"`
+	}
+	schema, err := Compile(createObject{})
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	limit := 10.0
+	jsonData := []byte(`{"name": "Daniel", "code": 7, "price": 1.5, "active": true}`)
+	tests := []struct {
+		name   string
+		schema *Schema
+		opts   []Option
+		want   []error
+	}{
+		{
+			name:   "test_value_fields_with_rule",
+			schema: schema.WithRule("name", "max=10").WithRule("code", "max=10").WithRule("price", "max=10").WithRule("active", "required=true"),
+			want:   nil,
+		},
+		{
+			name:   "test_value_fields_with_overrides",
+			schema: schema.WithRule("name", "min=1"),
+			opts:   []Option{WithOverrides(map[string]RuleOverride{"name": {Max: &limit}, "code": {Max: &limit}})},
+			want:   nil,
+		},
+		{
+			name:   "test_value_fields_with_flags",
+			schema: schema,
+			opts:   []Option{WithFlags("limits")},
+			want:   []error{ValidationError{Field: "name", Message: defaultMessage("InvalidMaxString", 5), Code: "max"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			if got := tt.schema.Validate(jsonData, form, tt.opts...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			want := createObject{Name: "Daniel", Code: 7, Price: 1.5, Active: true}
+			if tt.want != nil {
+				want.Name = ""
+			}
+			if *form != want {
+				t.Errorf("Validate() form = %+v, want %+v", *form, want)
+			}
+		})
+	}
+}
+
+func TestSchemaWithRule_SizedInts(t *testing.T) {
+	type createObject struct {
+		Size   *int32          `validations:"type=int;flag=limits;max=1000"`
+		Level  int8            `validations:"type=int;flag=limits;max=10"`
+		Counts []int16         `validations:"type=[]int;flag=limits;max=3"`
+		Scores map[string]int8 `validations:"type=map[string]int;flag=limits;max=100"`
+	}
+	schema, err := Compile(createObject{})
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	jsonData := []byte(`{"size": 500, "level": 5, "counts": [1, 2], "scores": {"a": 50}}`)
+	size := int32(500)
+	tests := []struct {
+		name   string
+		schema *Schema
+		opts   []Option
+	}{
+		{
+			name:   "test_sized_ints_with_rule",
+			schema: schema.WithRule("size", "min=1").WithRule("level", "min=1").WithRule("counts", "min=1").WithRule("scores", "minKeys=1"),
+		},
+		{
+			name:   "test_sized_ints_with_flags",
+			schema: schema,
+			opts:   []Option{WithFlags("limits")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			if got := tt.schema.Validate(jsonData, form, tt.opts...); got != nil {
+				t.Errorf("Validate() = %v, want nil", got)
+			}
+			want := createObject{Size: &size, Level: 5, Counts: []int16{1, 2}, Scores: map[string]int8{"a": 50}}
+			if !reflect.DeepEqual(*form, want) {
+				t.Errorf("Validate() form = %+v, want %+v", *form, want)
+			}
+		})
+	}
+}
+
+func TestSchemaWithRule_NullableFields(t *testing.T) {
+	type createObject struct {
+		Parent **string `validations:"type=string;nullable=true;flag=limits;max=10"`
+	}
+	schema, err := Compile(createObject{})
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	tests := []struct {
+		name     string
+		schema   *Schema
+		opts     []Option
+		jsonData string
+		want     *string
+		wantNull bool
+	}{
+		{
+			name:     "test_nullable_with_rule",
+			schema:   schema.WithRule("parent", "min=1"),
+			jsonData: `{"parent": "root"}`,
+			want:     toStringPointer("root"),
+		},
+		{
+			name:     "test_nullable_null_with_rule",
+			schema:   schema.WithRule("parent", "min=1"),
+			jsonData: `{"parent": null}`,
+			wantNull: true,
+		},
+		{
+			name:     "test_nullable_with_flags",
+			schema:   schema,
+			opts:     []Option{WithFlags("limits")},
+			jsonData: `{"parent": "root"}`,
+			want:     toStringPointer("root"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			if got := tt.schema.Validate([]byte(tt.jsonData), form, tt.opts...); got != nil {
+				t.Fatalf("Validate() = %v, want nil", got)
+			}
+			if form.Parent == nil || tt.wantNull != (*form.Parent == nil) || !tt.wantNull && !reflect.DeepEqual(*form.Parent, tt.want) {
+				t.Errorf("Validate() form = %+v, want %v (null %v)", form.Parent, tt.want, tt.wantNull)
+			}
+		})
+	}
+}
+
+func TestSchemaWithRule_Binding(t *testing.T) {
+	type createObject struct {
+		BaseFields
+		*AuditFields
+		Title  *string  `validations:"type=string"`
+		Code   int      `validations:"type=int"`
+		Size   *int32   `validations:"type=int"`
+		Parent **string `validations:"type=string;nullable=true"`
+	}
+	formValue := reflect.ValueOf(new(createObject)).Elem()
+	declared := getValidations(formValue, defaultSyntax())
+	rules := make(map[string][]string, len(declared))
+	for fieldName := range declared {
+		rules[fieldName] = []string{"required=false"}
+	}
+	s := &state{options: &options{rules: rules}}
+	for fieldName, validations := range s.withRules(formValue, declared, "") {
+		t.Run(fieldName, func(t *testing.T) {
+			want := declared[fieldName]
+			if validations == want {
+				t.Fatalf("withRules() did not parse the rules of %s", fieldName)
+			}
+			got := []any{validations.structField, validations.offset, validations.unsafeSet, validations.valueField, validations.nullField,
+				validations.intType, validations.index, validations.indirect}
+			wanted := []any{want.structField, want.offset, want.unsafeSet, want.valueField, want.nullField, want.intType, want.index,
+				want.indirect}
+			if !reflect.DeepEqual(got, wanted) {
+				t.Errorf("withRules() binding = %v, want %v", got, wanted)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package jsonValidator
+
+import "strconv"
+
+// sortOrders are the words of the "sorted=" orders in the UnsortedList message.
+var sortOrders = map[string]string{"asc": "ascending", "desc": "descending"}
+
+// validateSorted checks the order ("sorted=asc|desc") of the parsed values of a list, the equal values are allowed. The
+// error is reported on the first element out of order.
+func validateSorted[T string | int | int64 | uint64 | float64](s *state, validations *Validations, parsedValues []T, parent string) []error {
+	if validations.Sorted == "" {
+		return nil
+	}
+	for i := 1; i < len(parsedValues); i++ {
+		if validations.Sorted == "asc" && parsedValues[i] < parsedValues[i-1] || validations.Sorted == "desc" && parsedValues[i] > parsedValues[i-1] {
+			return s.unsortedError(validations, parent, i)
+		}
+	}
+	return nil
+}
+
+// validateSortedBy checks the order of the elements of a []struct field by one of their members ("sorted=asc|desc;
+// sortedBy=timestamp"). The numbers are compared by value and the strings (e.g. RFC 3339 datetimes) lexically, the
+// elements without the member or with a value of another type are left out.
+func (s *state) validateSortedBy(validations *Validations, valueList []int, parent string) []error {
+
+	// 1) Get the nodes of the member, if the order is declared.
+	if validations.Sorted == "" || validations.SortedBy == "" {
+		return nil
+	}
+	previous := -1
+
+	// 2) Compare each member with the previous one of the same type.
+	for i, element := range valueList {
+		node := s.document.member(element, validations.SortedBy)
+		if node < 0 || s.document.kind(node) != kindNumber && s.document.kind(node) != kindString {
+			continue
+		}
+		if comparison, ok := s.compareNodes(node, previous); ok {
+			if validations.Sorted == "asc" && comparison < 0 || validations.Sorted == "desc" && comparison > 0 {
+				return s.unsortedError(validations, parent, i)
+			}
+		}
+		previous = node
+	}
+	return nil
+}
+
+// compareNodes compares two numbers by value or two strings lexically, returning -1, 0 or 1. It reports false when
+// the nodes are not both numbers or both strings.
+func (s *state) compareNodes(a, b int) (int, bool) {
+	if a < 0 || b < 0 || s.document.kind(a) != s.document.kind(b) {
+		return 0, false
+	}
+	switch {
+	case s.document.kind(a) == kindNumber && s.document.numberValue(a) < s.document.numberValue(b),
+		s.document.kind(a) == kindString && s.document.stringValue(a) < s.document.stringValue(b):
+		return -1, true
+	case s.document.kind(a) == kindNumber && s.document.numberValue(a) > s.document.numberValue(b),
+		s.document.kind(a) == kindString && s.document.stringValue(a) > s.document.stringValue(b):
+		return 1, true
+	case s.document.kind(a) == kindNumber || s.document.kind(a) == kindString:
+		return 0, true
+	}
+	return 0, false
+}
+
+// unsortedError returns the UnsortedList error of the element i of a list.
+func (s *state) unsortedError(validations *Validations, parent string, i int) []error {
+	s.trigger(parent, "sorted")
+	return []error{ValidationError{
+		Field:   parent + "[" + strconv.Itoa(i) + "]",
+		Message: s.options.format("UnsortedList", parent+"["+strconv.Itoa(i)+"]", sortOrders[validations.Sorted]),
+		Code:    "sorted",
+	}}
+}
@@ -0,0 +1,58 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_Sorted(t *testing.T) {
+	type Point struct {
+		Timestamp *string `validations:"type=string"`
+		Value     *int    `validations:"type=int"`
+	}
+	type createObject struct {
+		Thresholds []int    `validations:"type=[]int;sorted=asc"`
+		Names      []string `validations:"type=[]string;sorted=desc"`
+		Points     []Point  `validations:"type=[]struct;sorted=asc;sortedBy=timestamp"`
+		Levels     []Point  `validations:"type=[]struct;sorted=desc;sortedBy=value"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name: "test_sorted",
+			jsonData: []byte(`{"thresholds": [1, 2, 2, 5], "names": ["c", "b", "a"],
+				"points": [{"timestamp": "2024-01-01T00:00:00Z"}, {}, {"timestamp": "2024-01-02T00:00:00Z"}],
+				"levels": [{"value": 10}, {"value": 3}]}`),
+			want: nil,
+		},
+		{
+			name: "test_sorted_out_of_order",
+			jsonData: []byte(`{"thresholds": [1, 5, 2, 3], "names": ["a", "b"],
+				"points": [{"timestamp": "2024-01-02T00:00:00Z"}, {"timestamp": "2024-01-03T00:00:00Z"}, {"timestamp": "2024-01-01T00:00:00Z"}],
+				"levels": [{"value": 3}, {"value": 10}]}`),
+			want: []error{
+				ValidationError{Field: "thresholds[2]", Message: defaultMessage("UnsortedList", "ascending"), Code: "sorted"},
+				ValidationError{Field: "names[1]", Message: defaultMessage("UnsortedList", "descending"), Code: "sorted"},
+				ValidationError{Field: "points[2]", Message: defaultMessage("UnsortedList", "ascending"), Code: "sorted"},
+				ValidationError{Field: "levels[1]", Message: defaultMessage("UnsortedList", "descending"), Code: "sorted"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"strings"
+)
+
+// SortTerm is a term of a sort expression, bound into the []SortTerm fields declared with "format=sortexpr".
+type SortTerm struct {
+	Field      string
+	Descending bool
+}
+
+// validateSortExpression validates a sort expression ("-createdAt,name") against the sortable fields and binds its
+// terms into the field.
+func (s *state) validateSortExpression(validations *Validations, fieldName string, value string, form reflect.Value) []error {
+
+	// 1) Parse each term, "-" marks the descending ones.
+	var errors []error
+	var terms []SortTerm
+	seen := make(map[string]bool)
+	for _, term := range strings.Split(value, ",") {
+		field, descending := strings.CutPrefix(strings.TrimSpace(term), "-")
+		switch {
+		case field == "" || seen[field]:
+			s.trigger(fieldName, "format")
+			return []error{ValidationError{
+				Field:   fieldName,
+				Message: s.options.format("InvalidSortExpression", fieldName, value),
+				Code:    "format",
+			}}
+		case validations.Fields != nil && !containsAny(toAny(validations.Fields), field):
+			s.trigger(fieldName, "fields")
+			errors = append(errors, ValidationError{
+				Field:   fieldName,
+				Message: s.choiceMessage(fieldName, field, &Validations{Choices: toAny(validations.Fields)}),
+				Code:    "choice",
+			})
+		}
+		seen[field] = true
+		terms = append(terms, SortTerm{Field: field, Descending: descending})
+	}
+	if errors != nil {
+		return errors
+	}
+
+	// 2) Update form with the terms.
+	setField(formField(form, validations), reflect.ValueOf(terms))
+
+	// 3) Return errors.
+	return nil
+}
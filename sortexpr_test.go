@@ -0,0 +1,53 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidate_SortExpression(t *testing.T) {
+	type createObject struct {
+		Sort []SortTerm `validations:"type=string;format=sortexpr;fields=name,createdAt"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+		wantForm *createObject
+	}{
+		{
+			name:     "test_sort_expression",
+			jsonData: []byte(`{"sort": "-createdAt, name"}`),
+			want:     nil,
+			wantForm: &createObject{Sort: []SortTerm{{Field: "createdAt", Descending: true}, {Field: "name"}}},
+		},
+		{
+			name:     "test_sort_expression_unknown_field",
+			jsonData: []byte(`{"sort": "-password"}`),
+			want: []error{
+				ValidationError{Field: "sort", Message: defaultMessage("InvalidChoice", "password", []any{"name", "createdAt"}), Code: "choice"},
+			},
+			wantForm: &createObject{},
+		},
+		{
+			name:     "test_sort_expression_invalid",
+			jsonData: []byte(`{"sort": "name,,-name"}`),
+			want: []error{
+				ValidationError{Field: "sort", Message: defaultMessage("InvalidSortExpression", "name,,-name"), Code: "format"},
+			},
+			wantForm: &createObject{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := new(createObject)
+			got := Validate(tt.jsonData, form)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(form, tt.wantForm) {
+				t.Errorf("Validate() form = %+v, want %+v", form, tt.wantForm)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package jsonValidator
+
+import (
+	"regexp"
+	"strings"
+)
+
+// subdivisionPattern matches the ISO 3166-2 subdivision codes: the ISO 3166-1 alpha-2 code of the country and up to
+// three alphanumeric characters ("US-CA", "ES-M", "GB-ENG").
+var subdivisionPattern = regexp.MustCompile(`^[A-Z]{2}-[A-Z0-9]{1,3}$`)
+
+// validateSubdivision checks the subdivision code of a received field declared with "format=iso3166-2;country=field"
+// against the country of the same object, when the country was received.
+func (s *state) validateSubdivision(objectNode int, validations *Validations, fieldName string, parent string) []error {
+
+	// 1) Get the subdivision and the country values, the invalid subdivisions were already reported.
+	if validations.Format != "iso3166-2" || validations.Country == "" {
+		return nil
+	}
+	subdivisionNode := s.document.member(objectNode, fieldName)
+	countryNode := s.document.member(objectNode, validations.Country)
+	if countryNode < 0 || s.document.kind(subdivisionNode) != kindString || s.document.kind(countryNode) != kindString {
+		return nil
+	}
+	subdivision, country := s.document.stringValue(subdivisionNode), s.document.stringValue(countryNode)
+	if !subdivisionPattern.MatchString(subdivision) {
+		return nil
+	}
+
+	// 2) Check the country of the subdivision.
+	if prefix, _, _ := strings.Cut(subdivision, "-"); !strings.EqualFold(prefix, country) {
+		s.trigger(getFieldName(parent, fieldName), "country")
+		return []error{ValidationError{
+			Field:   getFieldName(parent, fieldName),
+			Message: s.options.format("MismatchedSubdivision", getFieldName(parent, fieldName), country),
+			Code:    "country",
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_Subdivision(t *testing.T) {
+	type createObject struct {
+		Region  *string `validations:"type=string;format=iso3166-2;country=country"`
+		Country *string `validations:"type=string;pattern=^[A-Z]{2}$"`
+		State   *string `validations:"type=string;format=iso3166-2"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_subdivision",
+			jsonData: []byte(`{"region": "ES-M", "country": "ES", "state": "US-CA"}`),
+			want:     nil,
+		},
+		{
+			name:     "test_subdivision_without_country",
+			jsonData: []byte(`{"region": "GB-ENG"}`),
+			want:     nil,
+		},
+		{
+			name:     "test_subdivision_country",
+			jsonData: []byte(`{"region": "US-CA", "country": "ES"}`),
+			want: []error{
+				ValidationError{Field: "region", Message: defaultMessage("MismatchedSubdivision", "ES"), Code: "country"},
+			},
+		},
+		{
+			name:     "test_subdivision_invalid",
+			jsonData: []byte(`{"region": "Madrid", "country": "ES", "state": "us-ca"}`),
+			want: []error{
+				ValidationError{Field: "region", Message: DefaultMessages["InvalidSubdivision"], Code: "format"},
+				ValidationError{Field: "state", Message: DefaultMessages["InvalidSubdivision"], Code: "format"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
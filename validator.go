@@ -0,0 +1,94 @@
+package jsonValidator
+
+import (
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+)
+
+// Validator validates with its own configuration (tag name, separators, messages and behavior options), so several
+// configurations can be used in the same process. The package-level functions validate with the package defaults.
+type Validator struct {
+	opts []Option
+}
+
+// New returns a Validator applying the given options to every validation, before the options of each call.
+func New(opts ...Option) *Validator {
+	return &Validator{opts: opts}
+}
+
+// options returns the options of the validator followed by the options of the call.
+func (v *Validator) options(opts []Option) []Option {
+	return append(append([]Option(nil), v.opts...), opts...)
+}
+
+// Validate is the Validate function with the configuration of the validator.
+func (v *Validator) Validate(jsonData []byte, form any, opts ...Option) []error {
+	return Validate(jsonData, form, v.options(opts)...)
+}
+
+// ValidateReader is the ValidateReader function with the configuration of the validator.
+func (v *Validator) ValidateReader(r io.Reader, form any, opts ...Option) []error {
+	return ValidateReader(r, form, v.options(opts)...)
+}
+
+// DryRun is the DryRun function with the configuration of the validator.
+func (v *Validator) DryRun(jsonData []byte, form any, opts ...Option) ([]error, []Coercion) {
+	return DryRun(jsonData, form, v.options(opts)...)
+}
+
+// ValidateBulk is the ValidateBulk function with the configuration of the validator.
+func (v *Validator) ValidateBulk(jsonData []byte, makeForm func() any, opts ...Option) BulkResult {
+	return ValidateBulk(jsonData, makeForm, v.options(opts)...)
+}
+
+// ValidateHeaders is the ValidateHeaders function with the configuration of the validator.
+func (v *Validator) ValidateHeaders(h http.Header, form any, opts ...Option) []error {
+	return ValidateHeaders(h, form, v.options(opts)...)
+}
+
+// ValidateIdempotencyKey is the ValidateIdempotencyKey function with the configuration of the validator.
+func (v *Validator) ValidateIdempotencyKey(h http.Header, opts ...Option) (string, []error) {
+	return ValidateIdempotencyKey(h, v.options(opts)...)
+}
+
+// ValidatePathParams is the ValidatePathParams function with the configuration of the validator.
+func (v *Validator) ValidatePathParams(params map[string]string, form any, opts ...Option) []error {
+	return ValidatePathParams(params, form, v.options(opts)...)
+}
+
+// ValidateQuery is the ValidateQuery function with the configuration of the validator.
+func (v *Validator) ValidateQuery(query url.Values, form any, opts ...Option) []error {
+	return ValidateQuery(query, form, v.options(opts)...)
+}
+
+// ValidateFormValues is the ValidateFormValues function with the configuration of the validator.
+func (v *Validator) ValidateFormValues(values url.Values, form any, opts ...Option) []error {
+	return ValidateFormValues(values, form, v.options(opts)...)
+}
+
+// ValidateFieldSet is the ValidateFieldSet function with the configuration of the validator.
+func (v *Validator) ValidateFieldSet(fieldSet string, form any, opts ...Option) ([]string, []error) {
+	return ValidateFieldSet(fieldSet, form, v.options(opts)...)
+}
+
+// ValidatePagination is the ValidatePagination function with the configuration of the validator.
+func (v *Validator) ValidatePagination(query url.Values, opts ...Option) (*Pagination, []error) {
+	return ValidatePagination(query, v.options(opts)...)
+}
+
+// ValidateRequest is the ValidateRequest function with the configuration of the validator.
+func (v *Validator) ValidateRequest(r *http.Request, pathParams map[string]string, form any, opts ...Option) []error {
+	return ValidateRequest(r, pathParams, form, v.options(opts)...)
+}
+
+// ValidateMultipart is the ValidateMultipart function with the configuration of the validator.
+func (v *Validator) ValidateMultipart(r *multipart.Reader, form any, opts ...Option) []error {
+	return ValidateMultipart(r, form, v.options(opts)...)
+}
+
+// ValidateMultipartForm is the ValidateMultipartForm function with the configuration of the validator.
+func (v *Validator) ValidateMultipartForm(mf *multipart.Form, form any, opts ...Option) []error {
+	return ValidateMultipartForm(mf, form, v.options(opts)...)
+}
@@ -0,0 +1,97 @@
+package jsonValidator
+
+import (
+	"mime/multipart"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	type createObject struct {
+		Name *string `rules:"type=string|required=true|max=3"`
+		Code *int    `rules:"type=int|choices=1/Low+2/High"`
+	}
+	validator := New(
+		WithTagName("rules"),
+		WithSeparators("|", "+", "/"),
+		WithMessages(map[string]string{"RequiredField": "Este campo es obligatorio."}),
+	)
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_valid",
+			jsonData: []byte(`{"name": "abc", "code": 2}`),
+			want:     nil,
+		},
+		{
+			name:     "test_invalid",
+			jsonData: []byte(`{"code": 3}`),
+			want: []error{
+				ValidationError{Field: "code", Message: "This field has an invalid choice (3). The valid choices are ([Low High])", Code: "choice"},
+				ValidationError{Field: "name", Message: "Este campo es obligatorio.", Code: "required"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validator.Validate(tt.jsonData, new(createObject))
+			sort.Sort(Errors(got))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// The package-level Validate keeps the default configuration, which does not read the rules of the form.
+	if got := Validate([]byte(`{}`), new(createObject)); got != nil {
+		t.Errorf("Validate() = %v, want nil", got)
+	}
+}
+
+func TestValidator_ValidateReader(t *testing.T) {
+	type createObject struct {
+		Name *string `rules:"type=string|required=true"`
+	}
+	validator := New(WithTagName("rules"), WithSeparators("|", "+", "/"), WithMessages(map[string]string{"RequiredField": "Este campo es obligatorio."}))
+	got := validator.ValidateReader(strings.NewReader(`{}`), new(createObject))
+	want := []error{ValidationError{Field: "name", Message: "Este campo es obligatorio.", Code: "required"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateReader() = %v, want %v", got, want)
+	}
+}
+
+func TestValidator_ValidateBulk(t *testing.T) {
+	type createObject struct {
+		Name *string `rules:"type=string|required=true"`
+	}
+	validator := New(WithTagName("rules"), WithSeparators("|", "+", "/"))
+	got := validator.ValidateBulk([]byte(`[{"name": "Daniel"}, {}]`), func() any { return new(createObject) })
+	want := BulkResult{
+		Records: []BulkRecord{
+			{Index: 0, Status: BulkOk, Form: &createObject{Name: toStringPointer("Daniel")}},
+			{Index: 1, Status: BulkInvalid, Errors: []error{ValidationError{Field: "name", Message: DefaultMessages["RequiredField"], Code: "required"}}},
+		},
+		Valid:   1,
+		Invalid: 1,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateBulk() = %+v, want %+v", got, want)
+	}
+}
+
+func TestValidator_ValidateMultipartForm(t *testing.T) {
+	type createObject struct {
+		Name *string `rules:"type=string|required=true|max=3"`
+	}
+	validator := New(WithTagName("rules"), WithSeparators("|", "+", "/"))
+	got := validator.ValidateMultipartForm(&multipart.Form{Value: map[string][]string{"name": {"Daniel"}}}, new(createObject))
+	want := []error{ValidationError{Field: "name", Message: defaultMessage("InvalidMaxString", 3), Code: "max"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateMultipartForm() = %v, want %v", got, want)
+	}
+}
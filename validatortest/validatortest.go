@@ -0,0 +1,124 @@
+// Package validatortest provides helpers to assert the errors returned by jsonValidator in tests, against golden
+// files or expected lists, ignoring their order.
+package validatortest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"github.com/packntrack/jsonValidator"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// update rewrites the golden files with the received errors instead of comparing them
+// (go test ./... -validatortest.update).
+var update = flag.Bool("validatortest.update", false, "rewrite the golden files of validatortest.AssertGolden")
+
+// Sorted returns a copy of the errors sorted by their Error string, so two lists can be compared ignoring their order.
+func Sorted(errs []error) []error {
+	sorted := append([]error(nil), errs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Error() < sorted[j].Error()
+	})
+	return sorted
+}
+
+// AssertErrors fails the test if the received errors are not the expected ones, ignoring their order.
+func AssertErrors(t testing.TB, got, want []error) {
+	t.Helper()
+	if len(got) == 0 && len(want) == 0 {
+		return
+	}
+	if sortedGot, sortedWant := Sorted(got), Sorted(want); !reflect.DeepEqual(sortedGot, sortedWant) {
+		t.Errorf("errors = %v, want %v", sortedGot, sortedWant)
+	}
+}
+
+// AssertGolden fails the test if the received errors, rendered like jsonValidator.ValidationErrors
+// ({"errors":[{"field":"name","code":"required","message":"..."}]}), are not the ones of the golden file, ignoring their
+// order. The golden file is written instead when the tests run with -validatortest.update.
+func AssertGolden(t testing.TB, path string, errs []error) {
+	t.Helper()
+
+	// 1) Render the received errors in a stable order.
+	got, err := render(errs)
+	if err != nil {
+		t.Fatalf("rendering the errors: %v", err)
+	}
+
+	// 2) Rewrite the golden file when updating.
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("writing the golden file: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("writing the golden file: %v", err)
+		}
+		return
+	}
+
+	// 3) Compare the received errors with the golden ones, both rendered in the same order.
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading the golden file: %v (run the tests with -validatortest.update to write it)", err)
+	}
+	want, err := normalize(golden)
+	if err != nil {
+		t.Fatalf("reading the golden file %s: %v", path, err)
+	}
+	if normalized, _ := normalize(got); !bytes.Equal(normalized, want) {
+		t.Errorf("errors do not match %s\ngot:\n%s\nwant:\n%s", path, normalized, want)
+	}
+}
+
+// goldenErrors is the json rendering of jsonValidator.ValidationErrors.
+type goldenErrors struct {
+	Errors []goldenError `json:"errors"`
+}
+
+type goldenError struct {
+	Field   string `json:"field,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message"`
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+}
+
+// render renders the errors like jsonValidator.ValidationErrors, sorted and indented.
+func render(errs []error) ([]byte, error) {
+	data, err := json.Marshal(jsonValidator.ValidationErrors(errs))
+	if err != nil {
+		return nil, err
+	}
+	return normalize(data)
+}
+
+// normalize sorts the errors of a rendering by field, code and message, and indents it.
+func normalize(data []byte) ([]byte, error) {
+	var rendering goldenErrors
+	if err := json.Unmarshal(data, &rendering); err != nil {
+		return nil, err
+	}
+	if rendering.Errors == nil {
+		rendering.Errors = []goldenError{}
+	}
+	sort.SliceStable(rendering.Errors, func(i, j int) bool {
+		a, b := rendering.Errors[i], rendering.Errors[j]
+		if a.Field != b.Field {
+			return a.Field < b.Field
+		}
+		if a.Code != b.Code {
+			return a.Code < b.Code
+		}
+		return a.Message < b.Message
+	})
+	normalized, err := json.MarshalIndent(rendering, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(normalized, '\n'), nil
+}
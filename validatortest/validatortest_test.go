@@ -0,0 +1,104 @@
+package validatortest
+
+import (
+	"github.com/packntrack/jsonValidator"
+	"testing"
+)
+
+// recorder records the failures of an assertion instead of failing the test.
+type recorder struct {
+	testing.TB
+	failed bool
+}
+
+func (r *recorder) Helper()               {}
+func (r *recorder) Errorf(string, ...any) { r.failed = true }
+func (r *recorder) Fatalf(string, ...any) { r.failed = true }
+func (r *recorder) Logf(string, ...any)   {}
+func (r *recorder) Error(args ...any)     { r.failed = true }
+func (r *recorder) Fatal(args ...any)     { r.failed = true }
+func (r *recorder) Log(args ...any)       {}
+
+type createObject struct {
+	Name *string  `validations:"type=string;required=true"`
+	Age  *int     `validations:"type=int;min=18"`
+	Tags []string `validations:"type=[]string;max=2"`
+}
+
+func TestAssertGolden(t *testing.T) {
+	tests := []struct {
+		name     string
+		jsonData []byte
+		golden   string
+		failed   bool
+	}{
+		{
+			name:     "test_golden",
+			jsonData: []byte("{\"age\": 10, \"tags\": [\"a\", \"b\", \"c\"]}"),
+			golden:   "testdata/errors.golden.json",
+		},
+		{
+			name:     "test_golden_mismatch",
+			jsonData: []byte("{\"age\": 10}"),
+			golden:   "testdata/errors.golden.json",
+			failed:   true,
+		},
+		{
+			name:     "test_golden_no_errors",
+			jsonData: []byte("{\"name\": \"Daniel\"}"),
+			golden:   "testdata/no_errors.golden.json",
+		},
+		{
+			name:     "test_golden_missing_file",
+			jsonData: []byte("{\"name\": \"Daniel\"}"),
+			golden:   "testdata/missing.golden.json",
+			failed:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{TB: t}
+			AssertGolden(r, tt.golden, jsonValidator.Validate(tt.jsonData, new(createObject)))
+			if r.failed != tt.failed {
+				t.Errorf("AssertGolden() failed = %v, want %v", r.failed, tt.failed)
+			}
+		})
+	}
+}
+
+func TestAssertErrors(t *testing.T) {
+	required := jsonValidator.ValidationError{Field: "name", Message: "This field is required.", Code: "required"}
+	minimum := jsonValidator.ValidationError{Field: "age", Message: "This field must be bigger than 18.", Code: "min"}
+	tests := []struct {
+		name   string
+		got    []error
+		want   []error
+		failed bool
+	}{
+		{
+			name: "test_assert_errors_any_order",
+			got:  []error{required, minimum},
+			want: []error{minimum, required},
+		},
+		{
+			name:   "test_assert_errors_mismatch",
+			got:    []error{required},
+			want:   []error{minimum, required},
+			failed: true,
+		},
+		{
+			name: "test_assert_errors_nil_and_empty",
+			got:  nil,
+			want: []error{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{TB: t}
+			AssertErrors(r, tt.got, tt.want)
+			if r.failed != tt.failed {
+				t.Errorf("AssertErrors() failed = %v, want %v", r.failed, tt.failed)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package jsonValidator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Versions holds the successive versions of a form, so payloads of old versions are validated against their own form
+// and migrated to the latest one.
+type Versions struct {
+	field    string
+	names    []string
+	versions map[string]formVersion
+}
+
+type formVersion struct {
+	newForm func() any
+	migrate func(form any) (any, error)
+}
+
+// NewVersions creates the versions of a form. The version of a payload is read from the given json field when it is
+// not received from elsewhere (e.g. a header), the forms should declare that field.
+func NewVersions(field string) *Versions {
+	return &Versions{field: field, versions: make(map[string]formVersion)}
+}
+
+// Register adds a version, which must be registered from the oldest to the latest one. newForm allocates the form
+// of the version and migrate converts a validated form of this version into a form of the next one (it is ignored
+// for the latest version, the payloads of the other versions without a migration are reported as a ConfigError).
+func (v *Versions) Register(name string, newForm func() any, migrate func(form any) (any, error)) *Versions {
+	v.names = append(v.names, name)
+	v.versions[name] = formVersion{newForm: newForm, migrate: migrate}
+	return v
+}
+
+// Validate validates the json data against the form of the version and migrates it to the latest version. The version
+// is read from the version field of the payload when empty, and the latest version is used when there is none.
+func (v *Versions) Validate(jsonData []byte, version string, opts ...Option) (any, []error) {
+
+	// 1) Get the version of the payload.
+	if version == "" {
+		version = v.payloadVersion(jsonData)
+	}
+	if version == "" && len(v.names) > 0 {
+		version = v.names[len(v.names)-1]
+	}
+	index := -1
+	for i, name := range v.names {
+		if name == version {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, []error{ValidationError{
+			Field:   v.field,
+			Message: newOptions(opts).format("InvalidVersion", v.field, version, strings.Join(v.names, ", ")),
+			Code:    "version",
+		}}
+	}
+
+	// 2) Validate the payload against the form of its version.
+	form := v.versions[version].newForm()
+	if errors := Validate(jsonData, form, opts...); errors != nil {
+		return nil, errors
+	}
+
+	// 3) Migrate the form up to the latest version, the versions without a migration are reported as config errors.
+	for i, name := range v.names[index : len(v.names)-1] {
+		if v.versions[name].migrate == nil {
+			return nil, []error{ConfigError{Message: fmt.Sprintf("the version %s has no migration to the version %s", name, v.names[index+i+1])}}
+		}
+		migrated, err := v.versions[name].migrate(form)
+		if err != nil {
+			return nil, []error{err}
+		}
+		form = migrated
+	}
+
+	// 4) Return the latest form.
+	return form, nil
+}
+
+// payloadVersion returns the value of the version field of the json data, or an empty string.
+func (v *Versions) payloadVersion(jsonData []byte) string {
+	document, err := parseDocument(jsonData)
+	if err != nil || v.field == "" {
+		return ""
+	}
+	i := document.member(0, v.field)
+	switch {
+	case i < 0:
+		return ""
+	case document.kind(i) == kindString:
+		return document.stringValue(i)
+	case document.kind(i) == kindNumber:
+		return string(document.raw(i))
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,95 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestVersions_Validate(t *testing.T) {
+	type createObjectV1 struct {
+		Version  *string `validations:"type=string"`
+		FullName *string `validations:"type=string;required=true"`
+	}
+	type createObjectV2 struct {
+		Version   *string `validations:"type=string"`
+		FirstName *string `validations:"type=string;required=true"`
+		LastName  *string `validations:"type=string"`
+	}
+	versions := NewVersions("version").
+		Register("v1", func() any { return new(createObjectV1) }, func(form any) (any, error) {
+			v1 := form.(*createObjectV1)
+			firstName, lastName, _ := strings.Cut(*v1.FullName, " ")
+			return &createObjectV2{Version: toStringPointer("v2"), FirstName: &firstName, LastName: &lastName}, nil
+		}).
+		Register("v2", func() any { return new(createObjectV2) }, nil)
+
+	tests := []struct {
+		name     string
+		jsonData []byte
+		version  string
+		want     any
+		wantErrs []error
+	}{
+		{
+			name:     "test_version_from_payload",
+			jsonData: []byte("{\"version\": \"v1\", \"fullName\": \"Daniel Silva\"}"),
+			want:     &createObjectV2{Version: toStringPointer("v2"), FirstName: toStringPointer("Daniel"), LastName: toStringPointer("Silva")},
+		},
+		{
+			name:     "test_version_from_header",
+			jsonData: []byte("{\"fullName\": \"Daniel Silva\"}"),
+			version:  "v1",
+			want:     &createObjectV2{Version: toStringPointer("v2"), FirstName: toStringPointer("Daniel"), LastName: toStringPointer("Silva")},
+		},
+		{
+			name:     "test_latest_version",
+			jsonData: []byte("{\"firstName\": \"Daniel\"}"),
+			want:     &createObjectV2{FirstName: toStringPointer("Daniel")},
+		},
+		{
+			name:     "test_version_errors",
+			jsonData: []byte("{\"version\": \"v1\", \"firstName\": \"Daniel\"}"),
+			wantErrs: []error{
+				ValidationError{Field: "firstName", Message: DefaultMessages["InvalidField"], Code: "unknown_field"},
+				ValidationError{Field: "fullName", Message: DefaultMessages["RequiredField"], Code: "required"},
+			},
+		},
+		{
+			name:     "test_invalid_version",
+			jsonData: []byte("{\"version\": \"v3\"}"),
+			wantErrs: []error{ValidationError{Field: "version", Message: defaultMessage("InvalidVersion", "v3", "v1, v2"), Code: "version"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotErrs := versions.Validate(tt.jsonData, tt.version)
+			if tt.want != nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+
+			// Sort
+			sort.Sort(Errors(gotErrs))
+			sort.Sort(Errors(tt.wantErrs))
+
+			if !reflect.DeepEqual(gotErrs, tt.wantErrs) {
+				t.Errorf("Validate() = %v, want %v", gotErrs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestVersions_Validate_MissingMigration(t *testing.T) {
+	type createObject struct {
+		Name *string `validations:"type=string"`
+	}
+	versions := NewVersions("version").
+		Register("v1", func() any { return new(createObject) }, nil).
+		Register("v2", func() any { return new(createObject) }, nil)
+	_, got := versions.Validate([]byte("{\"name\": \"Daniel\"}"), "v1")
+	want := []error{ConfigError{Message: "the version v1 has no migration to the version v2"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate() = %v, want %v", got, want)
+	}
+}
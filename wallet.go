@@ -0,0 +1,93 @@
+package jsonValidator
+
+import "strings"
+
+// base58Alphabet is the Bitcoin base58 alphabet, without the 0, O, I and l characters.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// bech32Charset is the alphabet of the data part of the bech32 strings.
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// isBase58 reports whether the value is a base58 string (e.g. a legacy Bitcoin or a Solana address).
+func isBase58(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if !strings.ContainsRune(base58Alphabet, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// isBech32 reports whether the value is a bech32 (BIP 173) or bech32m (BIP 350) string with a valid checksum
+// (e.g. a SegWit address "bc1q...").
+func isBech32(value string) bool {
+
+	// 1) Check the length and the case, the strings must not mix cases.
+	if len(value) > 90 || strings.ToLower(value) != value && strings.ToUpper(value) != value {
+		return false
+	}
+	value = strings.ToLower(value)
+
+	// 2) Split the human-readable part from the data part, which has a 6 characters checksum.
+	separator := strings.LastIndexByte(value, '1')
+	if separator < 1 || separator+7 > len(value) {
+		return false
+	}
+	hrp, data := value[:separator], value[separator+1:]
+
+	// 3) Compute the checksum over the expanded human-readable part and the data.
+	values := make([]int, 0, len(hrp)*2+1+len(data))
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return false
+		}
+		values = append(values, int(hrp[i])>>5)
+	}
+	values = append(values, 0)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, int(hrp[i])&31)
+	}
+	for _, r := range data {
+		index := strings.IndexRune(bech32Charset, r)
+		if index < 0 {
+			return false
+		}
+		values = append(values, index)
+	}
+	checksum := bech32Polymod(values)
+	return checksum == 1 || checksum == 0x2bc830a3
+}
+
+// bech32Polymod is the BCH checksum of the bech32 strings.
+func bech32Polymod(values []int) int {
+	generator := [5]int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	checksum := 1
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum&0x1ffffff)<<5 ^ value
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				checksum ^= generator[i]
+			}
+		}
+	}
+	return checksum
+}
+
+// hasPrefix reports whether the value starts with one of the prefixes of the validations. The prefixes of the bech32
+// values are their whole human-readable part ("bc" for "bc1q...").
+func hasPrefix(validations *Validations, value string) bool {
+	for _, prefix := range validations.Prefixes {
+		if validations.Format == "bech32" {
+			if separator := strings.LastIndexByte(value, '1'); separator > 0 && strings.EqualFold(value[:separator], prefix) {
+				return true
+			}
+		} else if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package jsonValidator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidate_WalletFormats(t *testing.T) {
+	type createObject struct {
+		Legacy  *string  `validations:"type=string;format=base58;prefix=1,3"`
+		Segwit  *string  `validations:"type=string;format=bech32;prefix=bc"`
+		Payouts []string `validations:"type=[]string;format=bech32"`
+	}
+	tests := []struct {
+		name     string
+		jsonData []byte
+		want     []error
+	}{
+		{
+			name:     "test_wallet_formats",
+			jsonData: []byte(`{"legacy": "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "segwit": "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", "payouts": ["bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"]}`),
+			want:     nil,
+		},
+		{
+			name:     "test_wallet_formats_invalid",
+			jsonData: []byte(`{"legacy": "0BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "segwit": "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5", "payouts": ["Bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"]}`),
+			want: []error{
+				ValidationError{Field: "legacy", Message: DefaultMessages["InvalidBase58"], Code: "format"},
+				ValidationError{Field: "payouts[0]", Message: DefaultMessages["InvalidBech32"], Code: "format"},
+				ValidationError{Field: "segwit", Message: DefaultMessages["InvalidBech32"], Code: "format"},
+			},
+		},
+		{
+			name:     "test_wallet_prefixes",
+			jsonData: []byte(`{"legacy": "5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ", "segwit": "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"}`),
+			want: []error{
+				ValidationError{Field: "legacy", Message: defaultMessage("InvalidPrefix", []string{"1", "3"}), Code: "prefix"},
+				ValidationError{Field: "segwit", Message: defaultMessage("InvalidPrefix", []string{"bc"}), Code: "prefix"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.jsonData, new(createObject))
+
+			// Sort
+			sort.Sort(Errors(got))
+			sort.Sort(Errors(tt.want))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}